@@ -23,6 +23,7 @@ import (
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	wlogglog "github.com/palantir/witchcraft-go-logging/wlog-glog"
+	wloglogfmt "github.com/palantir/witchcraft-go-logging/wlog-logfmt"
 	wlogtmpl "github.com/palantir/witchcraft-go-logging/wlog-tmpl"
 	wlogzap "github.com/palantir/witchcraft-go-logging/wlog-zap"
 	wlogzerolog "github.com/palantir/witchcraft-go-logging/wlog-zerolog"
@@ -180,4 +181,9 @@ func RunBenchmarks(b *testing.B, benchmark func(*testing.B, wlog.LoggerProvider)
 	b.Run("zap", func(b *testing.B) { benchmark(b, wlogzap.LoggerProvider()) })
 	b.Run("zerolog", func(b *testing.B) { benchmark(b, wlogzerolog.LoggerProvider()) })
 	b.Run("tmpl", func(b *testing.B) { benchmark(b, wlogtmpl.LoggerProvider(nil)) })
+	b.Run("tmpl.json", func(b *testing.B) {
+		// formats entries by parsing the JSON written by a delegate logger, which is how tmpl used to format entries
+		benchmark(b, wlogtmpl.LoggerProvider(&wlogtmpl.Config{DelegateLogger: wlog.NewJSONMarshalLoggerProvider()}))
+	})
+	b.Run("logfmt", func(b *testing.B) { benchmark(b, wloglogfmt.LoggerProvider()) })
 }
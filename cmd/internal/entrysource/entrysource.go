@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package entrysource opens the log entries read by the command-line tools in this module.
+package entrysource
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+)
+
+// Open returns an EntryReader that merges the entries of the files at the provided paths in time order, or that reads
+// the entries from stdin if no paths are provided. Gzip-compressed files are supported. If filter is non-empty, only
+// the entries that match the query are returned. The returned function closes the files and must be called once the
+// reader is no longer used.
+func Open(paths []string, stdin io.Reader, filter string) (logreader.EntryReader, func(), error) {
+	var files []io.Closer
+	closeFiles := func() {
+		for _, f := range files {
+			// files are opened for reads only, so nothing to be done if there is an error closing them
+			_ = f.Close()
+		}
+	}
+
+	var readers []logreader.EntryReader
+	if len(paths) == 0 {
+		readers = append(readers, logreader.NewReader("stdin", stdin))
+	}
+	for _, path := range paths {
+		f, err := logreader.OpenFile(path)
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		files = append(files, f)
+		readers = append(readers, logreader.NewReader(path, f))
+	}
+	var r logreader.EntryReader = logreader.NewMerger(readers)
+	if filter != "" {
+		query, err := logreader.ParseQuery(filter)
+		if err != nil {
+			closeFiles()
+			return nil, nil, err
+		}
+		r = logreader.Filter(r, query.Matches)
+	}
+	return r, closeFiles, nil
+}
+
+// SkippedLines counts the lines that could not be decoded as log entries. Its Add method can be used as the onLineErr
+// function of logreader.Stream.
+type SkippedLines struct {
+	count int
+	first *logreader.LineError
+}
+
+// Add records a line that could not be decoded.
+func (s *SkippedLines) Add(err *logreader.LineError) {
+	if s.first == nil {
+		s.first = err
+	}
+	s.count++
+}
+
+// Report writes a summary of the skipped lines to w. Nothing is written if no lines were skipped.
+func (s *SkippedLines) Report(w io.Writer) {
+	if s.count == 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(w, "skipped %d line(s) that could not be decoded as log entries; first error: %v\n", s.count, s.first)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wlogconv converts JSON witchcraft log entries read from the files provided as arguments (gzip-compressed files are
+// supported) or from stdin if no files are provided to logfmt, CSV or NDJSON. Lines that are not JSON log entries are
+// skipped and the number of skipped lines is reported on stderr. When multiple files are provided, their entries are
+// merged in time order.
+//
+// Usage:
+//
+//	wlogconv [flags] [file...]
+//
+// Flags:
+//
+//	--format format   output format: "logfmt" (default), "csv" or "ndjson"
+//	--fields fields   comma-separated flattened fields to output, for example "time,level,message,params.*"
+//	--unsafe-params   include unsafe params in the output
+//	--keep-wrapped    output wrapped.1 entries as-is rather than the wrapped payload
+//	--filter query    only convert entries that match the query (see logreader.ParseQuery for the syntax)
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
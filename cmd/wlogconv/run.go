@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-logging/cmd/internal/entrysource"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader/logconv"
+)
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("wlogconv", flag.ContinueOnError)
+	format := fs.String("format", string(logconv.FormatLogfmt), `output format: "logfmt", "csv" or "ndjson"`)
+	fields := fs.String("fields", "", `comma-separated flattened fields to output, for example "time,level,message,params.*"`)
+	unsafeParams := fs.Bool("unsafe-params", false, "include unsafe params in the output")
+	keepWrapped := fs.Bool("keep-wrapped", false, "output wrapped.1 entries as-is rather than the wrapped payload")
+	filter := fs.String("filter", "", "only convert entries that match the query")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg := logconv.Config{
+		IncludeUnsafeParams: *unsafeParams,
+		KeepWrapped:         *keepWrapped,
+	}
+	for _, field := range strings.Split(*fields, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			cfg.Fields = append(cfg.Fields, field)
+		}
+	}
+	w, err := logconv.NewWriter(stdout, logconv.Format(*format), cfg)
+	if err != nil {
+		return err
+	}
+
+	r, closeFiles, err := entrysource.Open(fs.Args(), stdin, *filter)
+	if err != nil {
+		return err
+	}
+	defer closeFiles()
+	var skipped entrysource.SkippedLines
+	defer skipped.Report(stderr)
+
+	if err := logreader.Stream(context.Background(), r, 0, w.Write, skipped.Add); err != nil {
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	servicePath := filepath.Join(dir, "service.log")
+	require.NoError(t, os.WriteFile(servicePath, []byte(strings.Join([]string{
+		`{"type":"service.1","time":"2018-01-01T10:00:00Z","level":"INFO","message":"first","params":{"tenant":"acme"},"unsafeParams":{"user":"jdoe"}}`,
+		`not JSON`,
+		`{"type":"service.1","time":"2018-01-01T10:00:02Z","level":"ERROR","message":"third","params":{"tenant":"acme"}}`,
+	}, "\n")), 0644))
+	requestPath := filepath.Join(dir, "request.log")
+	require.NoError(t, os.WriteFile(requestPath, []byte(
+		`{"type":"request.2","time":"2018-01-01T10:00:01Z","method":"GET","path":"/items","status":200}`+"\n",
+	), 0644))
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "CSV of merged files",
+			args: []string{"--format", "csv", "--fields", "time,type,message,params.*,unsafeParams.*"},
+			want: "time,type,message,params.tenant\n" +
+				"2018-01-01T10:00:00Z,service.1,first,acme\n" +
+				"2018-01-01T10:00:01Z,request.2,,\n" +
+				"2018-01-01T10:00:02Z,service.1,third,acme\n",
+		},
+		{
+			name: "logfmt with filter and unsafe params",
+			args: []string{"--filter", `type == "service.1"`, "--fields", "level,message,unsafeParams.*", "--unsafe-params"},
+			want: "level=INFO message=first unsafeParams.user=jdoe\n" +
+				"level=ERROR message=third\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+			require.NoError(t, run(append(tc.args, servicePath, requestPath), nil, out, errOut))
+			assert.Equal(t, tc.want, out.String())
+			assert.Equal(t, "skipped 1 line(s) that could not be decoded as log entries; first error: failed to decode log entry from "+servicePath+": invalid character 'o' in literal null (expecting 'u')\n", errOut.String())
+		})
+	}
+}
+
+func TestRunStdin(t *testing.T) {
+	out := &bytes.Buffer{}
+	in := `{"type":"event.2","time":"2018-01-01T10:00:00Z","eventName":"my.event","values":{"count":1}}`
+	require.NoError(t, run([]string{"--format", "ndjson"}, strings.NewReader(in), out, io.Discard))
+	assert.Equal(t, `{"type":"event.2","time":"2018-01-01T10:00:00Z","eventName":"my.event","values.count":1}`+"\n", out.String())
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wlogfmt renders JSON witchcraft log lines in a human-readable format using the templates defined in wlog-tmpl.
+// Lines are read from the files provided as arguments (gzip-compressed files are supported) or from stdin if no files
+// are provided. Lines that are not JSON log entries are printed as-is.
+//
+// Usage:
+//
+//	wlogfmt [flags] [file...]
+//
+// Flags:
+//
+//	--only types           only print entries of the provided comma-separated log types
+//	--exclude types        do not print entries of the provided comma-separated log types
+//	--filter query         only print entries that match the query (see logreader.ParseQuery for the syntax)
+//	--template type=tmpl   use the provided template for the log type (may be specified multiple times)
+//	--no-color             do not colorize output (output is colorized if stdout is a terminal or FORCE_COLOR is set,
+//	                       unless NO_COLOR is set)
+//	--theme name           color theme: default, levels, mono or none
+//	--width n|auto         shorten output lines to n characters or to the width of the terminal
+//	--max-value-width n    shorten parameter values to n characters
+//	--multiline-params     print every parameter on its own indented line
+//	--collapse-stack-frames
+//	                       collapse repeated stack frames
+//	--relative-time        print times relative to the time of the first entry
+//	--waterfall            print trace.1 entries as waterfall diagrams of their traces after all of the input is read
+//	--no-substitution      do not substitute slf4j-style "{}" placeholders in service.1 messages
+//	--describe             print the default template and template object for every log type and exit
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/waterfall"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+)
+
+type config struct {
+	only           logTypesFlag
+	exclude        logTypesFlag
+	templates      templatesFlag
+	filter         string
+	noColor        bool
+	noSubstitution bool
+	describe       bool
+	theme          string
+	width          string
+	maxValueWidth  int
+	multiline      bool
+	collapseFrames bool
+	relativeTime   bool
+	waterfall      bool
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	cfg := config{
+		only:      logTypesFlag{},
+		exclude:   logTypesFlag{},
+		templates: templatesFlag{},
+	}
+	fs := flag.NewFlagSet("wlogfmt", flag.ContinueOnError)
+	fs.Var(cfg.only, "only", "only print entries of the provided comma-separated log types")
+	fs.Var(cfg.exclude, "exclude", "do not print entries of the provided comma-separated log types")
+	fs.Var(cfg.templates, "template", "template to use for a log type in the form type=template (may be specified multiple times)")
+	fs.StringVar(&cfg.filter, "filter", "", `only print entries that match the provided query, for example 'level >= WARN && params.tenant == "acme"'`)
+	fs.BoolVar(&cfg.noColor, "no-color", false, "do not colorize output (by default, output is colorized if stdout is a terminal or FORCE_COLOR is set, unless NO_COLOR is set)")
+	fs.StringVar(&cfg.theme, "theme", logs.DefaultTheme, fmt.Sprintf("color theme: one of %s", strings.Join(logs.ThemeNames(), ", ")))
+	fs.StringVar(&cfg.width, "width", "", `maximum width of output lines: a number of characters or "auto" to use the width of the terminal`)
+	fs.IntVar(&cfg.maxValueWidth, "max-value-width", 0, "maximum width of parameter values")
+	fs.BoolVar(&cfg.multiline, "multiline-params", false, "print every parameter on its own indented line")
+	fs.BoolVar(&cfg.collapseFrames, "collapse-stack-frames", false, "collapse repeated stack frames")
+	fs.BoolVar(&cfg.relativeTime, "relative-time", false, "print times relative to the time of the first entry")
+	fs.BoolVar(&cfg.waterfall, "waterfall", false, "print trace.1 entries as waterfall diagrams of their traces after all of the input is read")
+	fs.BoolVar(&cfg.noSubstitution, "no-substitution", false, `do not substitute slf4j-style "{}" placeholders in service.1 messages`)
+	fs.BoolVar(&cfg.describe, "describe", false, "print the default template and template object for every log type and exit")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	color.NoColor = cfg.noColor || !logentryformatter.ColorEnabled(stdout)
+
+	formatters, err := cfg.formatters(stdout)
+	if err != nil {
+		return err
+	}
+	if cfg.describe {
+		return describe(stdout, formatters)
+	}
+	p := &printer{
+		w:          stdout,
+		unwrappers: logs.Unwrappers,
+		formatters: formatters,
+		only:       cfg.only,
+		exclude:    cfg.exclude,
+	}
+	if cfg.filter != "" {
+		if p.filter, err = logreader.ParseQuery(cfg.filter); err != nil {
+			return err
+		}
+	}
+
+	if cfg.waterfall {
+		p.waterfall = waterfall.New()
+	}
+
+	if fs.NArg() == 0 {
+		if err := p.printLines(stdin); err != nil {
+			return err
+		}
+	}
+	for _, path := range fs.Args() {
+		if err := p.printFile(path); err != nil {
+			return err
+		}
+	}
+	if p.waterfall != nil {
+		return p.waterfall.RenderAll(stdout)
+	}
+	return nil
+}
+
+func (c config) formatters(stdout io.Writer) (map[logentryformatter.LogType]logentryformatter.Formatter, error) {
+	params, err := c.layoutParams(stdout)
+	if err != nil {
+		return nil, err
+	}
+	if c.noSubstitution {
+		params = append(params, logentryformatter.NoSubstitution())
+	}
+	themeParam, err := logs.ThemeParam(c.theme)
+	if err != nil {
+		return nil, err
+	}
+	params = append(params, themeParam)
+	formatters := logs.Formatters(params...)
+	for typ, tmpl := range c.templates {
+		formatter, err := logs.Formatter(typ, tmpl, params...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for log type %s: %v", typ, err)
+		}
+		formatters[typ] = formatter
+	}
+	return formatters, nil
+}
+
+func (c config) layoutParams(stdout io.Writer) ([]logentryformatter.Param, error) {
+	var params []logentryformatter.Param
+	switch c.width {
+	case "":
+	case "auto":
+		params = append(params, logentryformatter.Width(logentryformatter.TerminalWidth(stdout)))
+	default:
+		width, err := strconv.Atoi(c.width)
+		if err != nil || width < 0 {
+			return nil, fmt.Errorf(`width must be a non-negative number or "auto": %q`, c.width)
+		}
+		params = append(params, logentryformatter.Width(width))
+	}
+	params = append(params, logentryformatter.MaxValueWidth(c.maxValueWidth))
+	if c.multiline {
+		params = append(params, logentryformatter.MultilineParams())
+	}
+	if c.collapseFrames {
+		params = append(params, logentryformatter.CollapseStackFrames())
+	}
+	if c.relativeTime {
+		params = append(params, logentryformatter.RelativeTime(time.Time{}))
+	}
+	return params, nil
+}
+
+type printer struct {
+	w          io.Writer
+	unwrappers map[logentryformatter.LogType]logentryformatter.Unwrapper
+	formatters map[logentryformatter.LogType]logentryformatter.Formatter
+	only       map[logentryformatter.LogType]struct{}
+	exclude    map[logentryformatter.LogType]struct{}
+	filter     *logreader.Query
+	// waterfall collects the spans of trace.1 entries if they are rendered as waterfall diagrams.
+	waterfall *waterfall.Collector
+}
+
+func (p *printer) printFile(path string) error {
+	f, err := logreader.OpenFile(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// file is opened for reads only, so nothing to be done if there is an error closing it
+		_ = f.Close()
+	}()
+	return p.printLines(f)
+}
+
+// printLines prints the formatted version of every line read from r. Lines that cannot be formatted (for example,
+// because they are not JSON or are of an unknown type) are printed unmodified. If a filter is specified, only the
+// lines that are log entries matching the filter are printed.
+func (p *printer) printLines(r io.Reader) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if line = strings.TrimRight(line, "\r\n"); (line != "" || err == nil) && p.matches(line) && !p.collectSpan(line) {
+			out, formatErr := logentryformatter.FormatLogLine(line, p.unwrappers, p.formatters, p.only, p.exclude)
+			if formatErr != nil {
+				out = line
+			}
+			if formatErr != nil || out != "" {
+				if _, err := fmt.Fprintln(p.w, out); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+	}
+}
+
+func (p *printer) matches(line string) bool {
+	if p.filter == nil {
+		return true
+	}
+	jsonStart := strings.Index(line, "{")
+	if jsonStart == -1 {
+		return false
+	}
+	entry, err := logreader.ParseTypedEntry("", []byte(line[jsonStart:]))
+	if err != nil {
+		return false
+	}
+	return p.filter.Matches(entry)
+}
+
+// collectSpan adds the span of the provided line to the waterfall collector if the line is a trace.1 entry (or a
+// wrapped.1 entry containing one) that should be printed. Returns true if the span was added.
+func (p *printer) collectSpan(line string) bool {
+	if p.waterfall == nil {
+		return false
+	}
+	jsonStart := strings.Index(line, "{")
+	if jsonStart == -1 {
+		return false
+	}
+	lineJSON := []byte(line[jsonStart:])
+	var entry logging.TraceLogV1
+	if err := safejson.Unmarshal(lineJSON, &entry); err != nil {
+		return false
+	}
+	if unwrapper, ok := p.unwrappers[logentryformatter.LogType(entry.Type)]; ok {
+		contents, err := unwrapper.UnwrapLogLine(lineJSON)
+		if err != nil {
+			return false
+		}
+		entry = logging.TraceLogV1{}
+		if err := safejson.Unmarshal(contents, &entry); err != nil {
+			return false
+		}
+	}
+	const traceLogType = logentryformatter.LogType("trace.1")
+	if _, exclude := p.exclude[traceLogType]; entry.Type != string(traceLogType) || exclude {
+		return false
+	}
+	if _, include := p.only[traceLogType]; len(p.only) > 0 && !include {
+		return false
+	}
+	p.waterfall.Add(entry.Span)
+	return true
+}
+
+func describe(w io.Writer, formatters map[logentryformatter.LogType]logentryformatter.Formatter) error {
+	for _, typ := range logs.OrderedLogTypes() {
+		formatter := formatters[typ]
+		if _, err := fmt.Fprintf(w, "%s\n\nTemplate:\n%s\n\nTemplate object:\n%s\n\n", typ, formatter.RawTemplate(), formatter.TemplateObjectDescription()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// logTypesFlag is a flag.Value that accumulates comma-separated log types.
+type logTypesFlag map[logentryformatter.LogType]struct{}
+
+func (f logTypesFlag) String() string {
+	var types []string
+	for typ := range f {
+		types = append(types, string(typ))
+	}
+	return strings.Join(types, ",")
+}
+
+func (f logTypesFlag) Set(val string) error {
+	for _, typ := range strings.Split(val, ",") {
+		if typ = strings.TrimSpace(typ); typ != "" {
+			f[logentryformatter.LogType(typ)] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// templatesFlag is a flag.Value that accumulates templates specified in the form type=template.
+type templatesFlag map[logentryformatter.LogType]string
+
+func (f templatesFlag) String() string {
+	var templates []string
+	for typ, tmpl := range f {
+		templates = append(templates, fmt.Sprintf("%s=%s", typ, tmpl))
+	}
+	return strings.Join(templates, ",")
+}
+
+func (f templatesFlag) Set(val string) error {
+	parts := strings.SplitN(val, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("template must be in the form type=template: %q", val)
+	}
+	f[logentryformatter.LogType(parts[0])] = parts[1]
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	svc1Line = `{"type":"service.1","time":"2017-05-25T18:49:10.652Z","level":"INFO","message":"Special node for '{}' already exists","origin":"com.palantir.example.NodeCreator","params":{},"unsafeParams":{"0":"my-special-node"}}`
+	evt2Line = `{"type":"event.2","time":"2017-05-25T18:49:10.652Z","eventName":"my.event","values":{"key":"value"}}`
+)
+
+func TestRun(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		args  []string
+		input []string
+		want  []string
+	}{
+		{
+			name:  "formats entries and passes through other lines",
+			args:  []string{"--no-color"},
+			input: []string{"starting up", svc1Line, "", `{"message":"no type"}`},
+			want: []string{
+				"starting up",
+				"INFO  [2017-05-25T18:49:10.652Z] com.palantir.example.NodeCreator: Special node for 'my-special-node' already exists (0: my-special-node)",
+				"",
+				`{"message":"no type"}`,
+			},
+		},
+		{
+			name:  "no substitution",
+			args:  []string{"--no-color", "--no-substitution"},
+			input: []string{svc1Line},
+			want: []string{
+				"INFO  [2017-05-25T18:49:10.652Z] com.palantir.example.NodeCreator: Special node for '{}' already exists (0: my-special-node)",
+			},
+		},
+		{
+			name:  "only",
+			args:  []string{"--no-color", "--only", "event.2"},
+			input: []string{svc1Line, evt2Line},
+			want: []string{
+				"[2017-05-25T18:49:10.652Z] my.event (key: value)",
+			},
+		},
+		{
+			name:  "exclude",
+			args:  []string{"--no-color", "--exclude", "event.2,metric.1"},
+			input: []string{evt2Line, "not JSON"},
+			want: []string{
+				"not JSON",
+			},
+		},
+		{
+			name:  "filter",
+			args:  []string{"--no-color", "--filter", `level == INFO && unsafeParams."0" =~ "special"`},
+			input: []string{"not JSON", evt2Line, svc1Line},
+			want: []string{
+				"INFO  [2017-05-25T18:49:10.652Z] com.palantir.example.NodeCreator: Special node for 'my-special-node' already exists (0: my-special-node)",
+			},
+		},
+		{
+			name:  "layout",
+			args:  []string{"--no-color", "--multiline-params", "--max-value-width", "6", "--relative-time"},
+			input: []string{svc1Line, strings.Replace(evt2Line, "10.652Z", "12.152Z", 1)},
+			want: []string{
+				"INFO  [+0s]                      com.palantir.example.NodeCreator: Special node for 'my-special-node' already exists",
+				"    0: my-sp…",
+				"[+1.5s]                    my.event",
+				"    key: value",
+			},
+		},
+		{
+			name:  "width",
+			args:  []string{"--no-color", "--width", "30"},
+			input: []string{svc1Line},
+			want: []string{
+				"INFO  [2017-05-25T18:49:10.65…",
+			},
+		},
+		{
+			name:  "custom template",
+			args:  []string{"--no-color", "--template", "service.1={{.Level}}: {{.Message}}"},
+			input: []string{svc1Line},
+			want: []string{
+				"INFO: Special node for 'my-special-node' already exists",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := run(tc.args, strings.NewReader(strings.Join(tc.input, "\n")), out)
+			require.NoError(t, err)
+			assert.Equal(t, strings.Join(tc.want, "\n")+"\n", out.String())
+		})
+	}
+}
+
+func TestRunWaterfall(t *testing.T) {
+	const (
+		childLine = `{"type":"trace.1","time":"2017-05-25T18:49:10.652Z","span":{"traceId":"t1","id":"b","name":"child","parentId":"a","timestamp":1495738150001000,"duration":2000,"annotations":[]}}`
+		rootLine  = `{"type":"wrapped.1","entityName":"app","entityVersion":"1.0.0","payload":{"type":"traceLogV1","traceLogV1":{"type":"trace.1","time":"2017-05-25T18:49:10.652Z","span":{"traceId":"t1","id":"a","name":"root","timestamp":1495738150000000,"duration":4000,"annotations":[]}}}}`
+	)
+	out := &bytes.Buffer{}
+	err := run([]string{"--no-color", "--waterfall"}, strings.NewReader(strings.Join([]string{childLine, evt2Line, rootLine}, "\n")), out)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join([]string{
+		"[2017-05-25T18:49:10.652Z] my.event (key: value)",
+		"trace t1 (2 spans, 4ms)",
+		"  root           +0s       4ms  |████████████████████████████████████████|",
+		"  └─ child      +1ms       2ms  |          ████████████████████          |",
+		"",
+	}, "\n"), out.String())
+}
+
+func TestRunFiles(t *testing.T) {
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "service.log")
+	require.NoError(t, os.WriteFile(plainPath, []byte(svc1Line+"\n"), 0644))
+
+	gzipPath := filepath.Join(dir, "event-1.log.gz")
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	_, err := gzw.Write([]byte(evt2Line + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+	require.NoError(t, os.WriteFile(gzipPath, buf.Bytes(), 0644))
+
+	out := &bytes.Buffer{}
+	require.NoError(t, run([]string{"--no-color", "--no-substitution", plainPath, gzipPath}, nil, out))
+	assert.Equal(t, "INFO  [2017-05-25T18:49:10.652Z] com.palantir.example.NodeCreator: Special node for '{}' already exists (0: my-special-node)\n"+
+		"[2017-05-25T18:49:10.652Z] my.event (key: value)\n", out.String())
+}
+
+func TestRunInvalidFilter(t *testing.T) {
+	err := run([]string{"--filter", "level >="}, strings.NewReader(""), &bytes.Buffer{})
+	assert.EqualError(t, err, "expected value at position 8 but found end of query")
+}
+
+func TestRunInvalidTheme(t *testing.T) {
+	err := run([]string{"--theme", "rainbow"}, strings.NewReader(""), &bytes.Buffer{})
+	assert.EqualError(t, err, `unknown theme "rainbow": valid themes are default, levels, mono, none`)
+}
+
+func TestRunInvalidTemplate(t *testing.T) {
+	err := run([]string{"--template", "service.1={{.Level"}, strings.NewReader(""), &bytes.Buffer{})
+	assert.Error(t, err)
+}
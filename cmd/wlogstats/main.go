@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// wlogstats summarizes JSON witchcraft log entries read from the files provided as arguments (gzip-compressed files are
+// supported) or from stdin if no files are provided. request.2 entries are summarized per endpoint (count, latency
+// percentiles, 4xx/5xx rates and request/response sizes), service.1 ERROR entries are counted per origin and event.2
+// entries are counted per event name. Lines that are not JSON log entries are skipped and the number of skipped lines is
+// reported on stderr.
+//
+// Usage:
+//
+//	wlogstats [flags] [file...]
+//
+// Flags:
+//
+//	--window duration   aggregate entries into windows of the provided size (for example, 5m); 0 aggregates all entries
+//	--format format     output format: "table" (default) or "json"
+//	--filter query      only include entries that match the query (see logreader.ParseQuery for the syntax)
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
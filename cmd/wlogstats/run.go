@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/cmd/internal/entrysource"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("wlogstats", flag.ContinueOnError)
+	window := fs.Duration("window", 0, "aggregate entries into windows of the provided size (for example, 5m); 0 aggregates all entries")
+	format := fs.String("format", formatTable, `output format: "table" or "json"`)
+	filter := fs.String("filter", "", "only include entries that match the query")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != formatTable && *format != formatJSON {
+		return fmt.Errorf("invalid format %q: must be %q or %q", *format, formatTable, formatJSON)
+	}
+
+	r, closeFiles, err := entrysource.Open(fs.Args(), stdin, *filter)
+	if err != nil {
+		return err
+	}
+	defer closeFiles()
+	var skipped entrysource.SkippedLines
+	defer skipped.Report(stderr)
+
+	agg := logreader.NewStatsAggregator(*window)
+	if err := logreader.Stream(context.Background(), r, 0, func(entry logreader.TypedEntry) error {
+		agg.Add(entry)
+		return nil
+	}, skipped.Add); err != nil {
+		return err
+	}
+
+	if *format == formatJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(agg.Stats())
+	}
+	return writeTable(stdout, agg.Stats(), *window > 0)
+}
+
+func writeTable(w io.Writer, stats []logreader.WindowStats, windowed bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, window := range stats {
+		if window.Start != nil {
+			_, _ = fmt.Fprintf(tw, "Window %s - %s\n\n", window.Start.Format(time.RFC3339), window.End.Format(time.RFC3339))
+		} else if windowed {
+			_, _ = fmt.Fprint(tw, "Entries without a valid time\n\n")
+		}
+		if len(window.Requests) > 0 {
+			_, _ = fmt.Fprintln(tw, "METHOD\tPATH\tCOUNT\tP50\tP90\tP99\tMAX\t4XX\t5XX\tREQUEST SIZE (MEAN/MAX)\tRESPONSE SIZE (MEAN/MAX)")
+			for _, req := range window.Requests {
+				_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%.1f%%\t%.1f%%\t%.0f/%.0f\t%.0f/%.0f\n",
+					req.Method, req.Path, req.Count,
+					micros(req.Latency.P50), micros(req.Latency.P90), micros(req.Latency.P99), micros(req.Latency.Max),
+					100*req.ClientErrorRate, 100*req.ServerErrorRate,
+					req.RequestSize.Mean, req.RequestSize.Max, req.ResponseSize.Mean, req.ResponseSize.Max)
+			}
+			_, _ = fmt.Fprintln(tw)
+		}
+		if len(window.ServiceErrors) > 0 {
+			_, _ = fmt.Fprintln(tw, "ORIGIN\tERRORS")
+			for _, count := range window.ServiceErrors {
+				_, _ = fmt.Fprintf(tw, "%s\t%d\n", count.Key, count.Count)
+			}
+			_, _ = fmt.Fprintln(tw)
+		}
+		if len(window.Events) > 0 {
+			_, _ = fmt.Fprintln(tw, "EVENT\tCOUNT")
+			for _, count := range window.Events {
+				_, _ = fmt.Fprintf(tw, "%s\t%d\n", count.Key, count.Count)
+			}
+			_, _ = fmt.Fprintln(tw)
+		}
+	}
+	return tw.Flush()
+}
+
+func micros(v float64) string {
+	return (time.Duration(v) * time.Microsecond).String()
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testInput = strings.Join([]string{
+	`{"type":"request.2","time":"2018-01-01T10:00:01Z","method":"GET","path":"/items/{id}","status":200,"duration":1000,"requestSize":0,"responseSize":10}`,
+	`{"type":"request.2","time":"2018-01-01T10:00:02Z","method":"GET","path":"/items/{id}","status":503,"duration":3000,"requestSize":0,"responseSize":30}`,
+	`not JSON`,
+	`{"type":"service.1","time":"2018-01-01T10:01:05Z","level":"ERROR","origin":"foo.go:10","message":"failed"}`,
+	`{"type":"event.2","time":"2018-01-01T10:01:07Z","eventName":"my.event"}`,
+}, "\n")
+
+func TestRunTable(t *testing.T) {
+	out := &bytes.Buffer{}
+	require.NoError(t, run([]string{"--window", "1m"}, strings.NewReader(testInput), out, io.Discard))
+	assert.Equal(t, `Window 2018-01-01T10:00:00Z - 2018-01-01T10:01:00Z
+
+METHOD  PATH         COUNT  P50  P90  P99  MAX  4XX   5XX    REQUEST SIZE (MEAN/MAX)  RESPONSE SIZE (MEAN/MAX)
+GET     /items/{id}  2      1ms  3ms  3ms  3ms  0.0%  50.0%  0/0                      20/30
+
+Window 2018-01-01T10:01:00Z - 2018-01-01T10:02:00Z
+
+ORIGIN     ERRORS
+foo.go:10  1
+
+EVENT     COUNT
+my.event  1
+
+`, out.String())
+}
+
+func TestRunJSON(t *testing.T) {
+	out := &bytes.Buffer{}
+	require.NoError(t, run([]string{"--format", "json", "--filter", `type == "event.2"`}, strings.NewReader(testInput), out, io.Discard))
+	assert.JSONEq(t, `[{"requests":null,"serviceErrors":null,"events":[{"key":"my.event","count":1}]}]`, out.String())
+}
+
+func TestRunInvalidFormat(t *testing.T) {
+	err := run([]string{"--format", "xml"}, strings.NewReader(""), &bytes.Buffer{}, io.Discard)
+	assert.EqualError(t, err, `invalid format "xml": must be "table" or "json"`)
+}
@@ -26,6 +26,11 @@ type gLogger struct {
 	*wlog.AtomicLogLevel
 }
 
+func (*gLogger) Flush() error {
+	glog.Flush()
+	return nil
+}
+
 func (*gLogger) Log(params ...wlog.Param) {
 	glog.Info(createGLogMsg("", params))
 }
@@ -17,6 +17,7 @@ package glogimpl
 import (
 	"io"
 
+	"github.com/golang/glog"
 	"github.com/palantir/witchcraft-go-logging/wlog"
 )
 
@@ -33,3 +34,12 @@ func (lp *loggerProvider) NewLogger(w io.Writer) wlog.Logger {
 func (lp *loggerProvider) NewLeveledLogger(w io.Writer, level wlog.LogLevel) wlog.LeveledLogger {
 	return &gLogger{AtomicLogLevel: wlog.NewAtomicLogLevel(level)}
 }
+
+func (lp *loggerProvider) Flush() error {
+	glog.Flush()
+	return nil
+}
+
+func (lp *loggerProvider) Close() error {
+	return lp.Flush()
+}
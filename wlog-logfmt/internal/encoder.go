@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfmtimpl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+// leadingKeys are the keys that are written first (in this order) if they are present.
+var leadingKeys = []string{
+	wlog.TimeKey,
+	wlog.TypeKey,
+	"level",
+}
+
+// encodeEntry writes the provided values to buf as a single line of logfmt. Nested values are flattened.
+func encodeEntry(buf *bytes.Buffer, values map[string]interface{}) {
+	fields := make(map[string]string)
+	for k, v := range values {
+		flatten(fields, k, v)
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		if !isLeadingKey(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	first := true
+	writePair := func(k string) {
+		if !first {
+			buf.WriteByte(' ')
+		}
+		first = false
+		writeKey(buf, k)
+		buf.WriteByte('=')
+		writeValue(buf, fields[k])
+	}
+	for _, k := range leadingKeys {
+		if _, ok := fields[k]; ok {
+			writePair(k)
+		}
+	}
+	for _, k := range keys {
+		writePair(k)
+	}
+	buf.WriteByte('\n')
+}
+
+func isLeadingKey(key string) bool {
+	for _, k := range leadingKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// flatten adds the string representation of the provided value to fields. Maps (and values that are encoded as JSON
+// objects) are flattened by joining their keys to the provided key using ".". Empty maps are omitted and arrays are
+// written as JSON.
+func flatten(fields map[string]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		fields[key] = "null"
+	case string:
+		fields[key] = v
+	case bool:
+		fields[key] = strconv.FormatBool(v)
+	case int:
+		fields[key] = strconv.Itoa(v)
+	case int32:
+		fields[key] = strconv.FormatInt(int64(v), 10)
+	case int64:
+		fields[key] = strconv.FormatInt(v, 10)
+	case uint64:
+		fields[key] = strconv.FormatUint(v, 10)
+	case float64:
+		fields[key] = strconv.FormatFloat(v, 'f', -1, 64)
+	case json.Number:
+		fields[key] = v.String()
+	case time.Time:
+		fields[key] = v.UTC().Format(time.RFC3339Nano)
+	case time.Duration:
+		fields[key] = v.String()
+	case error:
+		fields[key] = v.Error()
+	case map[string]string:
+		for k, mv := range v {
+			fields[key+"."+k] = mv
+		}
+	case map[string]interface{}:
+		for k, mv := range v {
+			flatten(fields, key+"."+k, mv)
+		}
+	case []string:
+		fields[key] = jsonString(v)
+	case []interface{}:
+		fields[key] = jsonString(v)
+	default:
+		// encode other types as JSON and flatten the result so that structs and typed maps are handled uniformly
+		b, err := json.Marshal(v)
+		if err != nil {
+			fields[key] = fmt.Sprint(v)
+			return
+		}
+		var generic interface{}
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		if err := dec.Decode(&generic); err != nil {
+			fields[key] = string(b)
+			return
+		}
+		if str, ok := generic.(string); ok {
+			fields[key] = str
+			return
+		}
+		flatten(fields, key, generic)
+	}
+}
+
+func jsonString(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// writeKey writes the provided key, replacing characters that are not valid in a logfmt key with '_'.
+func writeKey(buf *bytes.Buffer, key string) {
+	if key == "" {
+		buf.WriteByte('_')
+		return
+	}
+	for _, r := range key {
+		if r <= ' ' || r == '=' || r == '"' || r == utf8.RuneError || !unicode.IsPrint(r) {
+			r = '_'
+		}
+		buf.WriteRune(r)
+	}
+}
+
+// writeValue writes the provided value, quoting and escaping it if it is empty or contains whitespace, '=', '"', '\'
+// or non-printable characters.
+func writeValue(buf *bytes.Buffer, value string) {
+	if value != "" && strings.IndexFunc(value, needsQuoting) == -1 {
+		buf.WriteString(value)
+		return
+	}
+	buf.WriteString(strconv.Quote(value))
+}
+
+func needsQuoting(r rune) bool {
+	return r <= ' ' || r == '=' || r == '"' || r == '\\' || r == utf8.RuneError || !unicode.IsPrint(r)
+}
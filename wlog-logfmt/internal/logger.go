@@ -0,0 +1,71 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfmtimpl
+
+import (
+	"io"
+
+	"github.com/palantir/pkg/bytesbuffers"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+type logfmtLogger struct {
+	w io.Writer
+	*wlog.AtomicLogLevel
+	bufferPool bytesbuffers.Pool
+}
+
+func (l *logfmtLogger) Log(params ...wlog.Param) {
+	l.logOutput("", params)
+}
+
+func (l *logfmtLogger) Debug(msg string, params ...wlog.Param) {
+	if l.Enabled(wlog.DebugLevel) {
+		l.logOutput(msg, params)
+	}
+}
+
+func (l *logfmtLogger) Info(msg string, params ...wlog.Param) {
+	if l.Enabled(wlog.InfoLevel) {
+		l.logOutput(msg, params)
+	}
+}
+
+func (l *logfmtLogger) Warn(msg string, params ...wlog.Param) {
+	if l.Enabled(wlog.WarnLevel) {
+		l.logOutput(msg, params)
+	}
+}
+
+func (l *logfmtLogger) Error(msg string, params ...wlog.Param) {
+	if l.Enabled(wlog.ErrorLevel) {
+		l.logOutput(msg, params)
+	}
+}
+
+func (l *logfmtLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
+func (l *logfmtLogger) logOutput(msg string, params []wlog.Param) {
+	entry := wlog.NewMapLogEntry()
+	wlog.ApplyParams(entry, wlog.ParamsWithMessage(msg, params))
+
+	buf := l.bufferPool.Get()
+	defer l.bufferPool.Put(buf)
+	encodeEntry(buf, entry.AllValues())
+	// write the entire line in a single call so that concurrent writes to the same writer are not interleaved
+	_, _ = l.w.Write(buf.Bytes())
+}
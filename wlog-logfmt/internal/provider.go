@@ -0,0 +1,53 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logfmtimpl
+
+import (
+	"io"
+
+	"github.com/palantir/pkg/bytesbuffers"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+func LoggerProvider() wlog.LoggerProvider {
+	return &loggerProvider{}
+}
+
+type loggerProvider struct{}
+
+func (lp *loggerProvider) NewLogger(w io.Writer) wlog.Logger {
+	return &logfmtLogger{
+		w:          w,
+		bufferPool: bytesbuffers.NewSyncPool(256),
+	}
+}
+
+func (lp *loggerProvider) NewLeveledLogger(w io.Writer, level wlog.LogLevel) wlog.LeveledLogger {
+	return &logfmtLogger{
+		w:              w,
+		AtomicLogLevel: wlog.NewAtomicLogLevel(level),
+		bufferPool:     bytesbuffers.NewSyncPool(256),
+	}
+}
+
+// Flush does nothing: the provider does not buffer output. Flush the loggers that it creates to flush their writers.
+func (lp *loggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources.
+func (lp *loggerProvider) Close() error {
+	return nil
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wloglogfmt_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	logfmtimpl "github.com/palantir/witchcraft-go-logging/wlog-logfmt/internal"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log/audit2logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log/diag1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log/evt2logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log/metric1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log/svc1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/wrappedlog/wrapped1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var timeRegexp = regexp.MustCompile(`^time=\S+ `)
+
+func TestLogOutput(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		log  func(w io.Writer)
+		want string
+	}{
+		{
+			name: "service.1 with nested params",
+			log: func(w io.Writer) {
+				svc1log.NewFromCreator(w, wlog.DebugLevel, logfmtimpl.LoggerProvider().NewLeveledLogger).Info(
+					"hello world",
+					svc1log.SafeParams(map[string]interface{}{"count": 1, "nested": map[string]interface{}{"key": "val"}}),
+					svc1log.UnsafeParam("path", `C:\dir "quoted"`),
+					svc1log.Tag("env", "prod"),
+				)
+			},
+			want: `time=<time> type=service.1 level=INFO message="hello world" params.count=1 params.nested.key=val tags.env=prod unsafeParams.path="C:\\dir \"quoted\""`,
+		},
+		{
+			name: "service.1 with multi-line message and empty param",
+			log: func(w io.Writer) {
+				svc1log.NewFromCreator(w, wlog.DebugLevel, logfmtimpl.LoggerProvider().NewLeveledLogger).Error(
+					"line one\nline two",
+					svc1log.SafeParam("empty", ""),
+					svc1log.SafeParam("eq", "a=b"),
+				)
+			},
+			want: `time=<time> type=service.1 level=ERROR message="line one\nline two" params.empty="" params.eq="a=b"`,
+		},
+		{
+			name: "event.2",
+			log: func(w io.Writer) {
+				evt2log.NewFromCreator(w, logfmtimpl.LoggerProvider().NewLogger).Event(
+					"my.event",
+					evt2log.Values(map[string]interface{}{"ids": []string{"a", "b"}}),
+					evt2log.UID("user-1"),
+				)
+			},
+			want: `time=<time> type=event.2 eventName=my.event uid=user-1 values.ids="[\"a\",\"b\"]"`,
+		},
+		{
+			name: "metric.1",
+			log: func(w io.Writer) {
+				metric1log.NewFromCreator(w, logfmtimpl.LoggerProvider().NewLogger).Metric(
+					"my.metric",
+					"gauge",
+					metric1log.Value("value", 1.5),
+					metric1log.Tag("host", "localhost"),
+				)
+			},
+			want: `time=<time> type=metric.1 metricName=my.metric metricType=gauge tags.host=localhost values.value=1.5`,
+		},
+		{
+			name: "request.2",
+			log: func(w io.Writer) {
+				req, err := http.NewRequest(http.MethodGet, "http://localhost/items/1", nil)
+				require.NoError(t, err)
+				req2log.New(w, req2log.Creator(logfmtimpl.LoggerProvider().NewLogger)).Request(req2log.Request{
+					Request: req,
+					RouteInfo: req2log.RouteInfo{
+						Template:   "/items/{id}",
+						PathParams: map[string]string{"id": "1"},
+					},
+					ResponseStatus: 200,
+					ResponseSize:   10,
+					Duration:       5 * time.Millisecond,
+				})
+			},
+			want: `time=<time> type=request.2 duration=5000 method=GET path=/items/{id} protocol=HTTP/1.1 requestSize=0 responseSize=10 status=200 unsafeParams.id=1`,
+		},
+		{
+			name: "wrapped.1 service.1",
+			log: func(w io.Writer) {
+				wrapped1log.NewFromProvider(w, wlog.InfoLevel, logfmtimpl.LoggerProvider(), "my-service", "1.0.0").Service().Warn("wrapped")
+			},
+			want: `type=wrapped.1 entityName=my-service entityVersion=1.0.0 payload.serviceLogV1.level=WARN payload.serviceLogV1.message=wrapped payload.serviceLogV1.time=<time> payload.serviceLogV1.type=service.1 payload.type=serviceLogV1`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			tc.log(buf)
+			out := strings.TrimSuffix(buf.String(), "\n")
+			require.NotContains(t, out, "\n")
+			out = timeRegexp.ReplaceAllString(out, "time=<time> ")
+			out = regexp.MustCompile(`payload\.serviceLogV1\.time=\S+`).ReplaceAllString(out, "payload.serviceLogV1.time=<time>")
+			assert.Equal(t, tc.want, out)
+		})
+	}
+}
+
+// TestAllLogTypes verifies that the test cases of every log type are written as a single well-formed logfmt line with
+// the time and type keys first.
+func TestAllLogTypes(t *testing.T) {
+	provider := logfmtimpl.LoggerProvider()
+	buf := &bytes.Buffer{}
+	for _, tc := range svc1logtests.TestCases() {
+		svc1log.NewFromCreator(buf, wlog.DebugLevel, provider.NewLeveledLogger, svc1log.Origin(tc.Origin)).Info(tc.Message, tc.LogParams...)
+	}
+	for _, tc := range evt2logtests.TestCases() {
+		evt2log.NewFromCreator(buf, provider.NewLogger).Event(tc.EventName, tc.Params()...)
+	}
+	for _, tc := range metric1logtests.TestCases() {
+		metric1log.NewFromCreator(buf, provider.NewLogger).Metric(tc.MetricName, tc.MetricType, tc.Params()...)
+	}
+	for _, tc := range audit2logtests.TestCases() {
+		audit2log.NewFromCreator(buf, provider.NewLogger).Audit(tc.AuditName, tc.AuditResult, tc.Params()...)
+	}
+	for _, tc := range diag1logtests.TestCases() {
+		diag1log.NewFromCreator(buf, provider.NewLogger).Diagnostic(tc.Diagnostic, diag1log.UnsafeParams(tc.UnsafeParams))
+	}
+	trc1log.NewFromCreator(buf, provider.NewLogger).Send(wtracing.SpanModel{
+		SpanContext: wtracing.SpanContext{TraceID: "trace-1", ID: "span-1"},
+		Name:        "span",
+		Timestamp:   time.Now(),
+		Duration:    time.Second,
+	})
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.NotEmpty(t, lines)
+	for _, line := range lines {
+		pairs, err := parseLogfmt(line)
+		require.NoError(t, err, line)
+		require.True(t, len(pairs) >= 2, line)
+		assert.Equal(t, "time", pairs[0][0], line)
+		assert.Equal(t, "type", pairs[1][0], line)
+	}
+}
+
+func TestConcurrentWritesAreNotInterleaved(t *testing.T) {
+	w := &countingWriter{}
+	logger := svc1log.NewFromCreator(w, wlog.InfoLevel, logfmtimpl.LoggerProvider().NewLeveledLogger)
+	logger.Info("message", svc1log.Stacktrace(errors.New("failed")))
+	assert.Equal(t, 1, w.writes)
+}
+
+type countingWriter struct {
+	writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return len(p), nil
+}
+
+// parseLogfmt parses a line of logfmt into key/value pairs, unquoting quoted values.
+func parseLogfmt(line string) ([][2]string, error) {
+	var pairs [][2]string
+	for len(line) > 0 {
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("missing key in %q", line)
+		}
+		key := line[:eq]
+		if strings.ContainsAny(key, " \"") {
+			return nil, fmt.Errorf("invalid key %q", key)
+		}
+		line = line[eq+1:]
+
+		var val string
+		if strings.HasPrefix(line, `"`) {
+			end := 1
+			for ; end < len(line) && line[end] != '"'; end++ {
+				if line[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(line) {
+				return nil, fmt.Errorf("unterminated value for key %q", key)
+			}
+			unquoted, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				return nil, err
+			}
+			val, line = unquoted, line[end+1:]
+		} else if space := strings.IndexByte(line, ' '); space >= 0 {
+			val, line = line[:space], line[space:]
+		} else {
+			val, line = line, ""
+		}
+		pairs = append(pairs, [2]string{key, val})
+		line = strings.TrimPrefix(line, " ")
+	}
+	return pairs, nil
+}
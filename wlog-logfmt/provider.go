@@ -0,0 +1,27 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wloglogfmt
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	logfmtimpl "github.com/palantir/witchcraft-go-logging/wlog-logfmt/internal"
+)
+
+// LoggerProvider returns a wlog.LoggerProvider that writes every log entry as a single line of logfmt-formatted
+// key=value pairs. Nested values are flattened using dot-separated keys and the "time", "type" and "level" keys are
+// written first, followed by all other keys in sorted order.
+func LoggerProvider() wlog.LoggerProvider {
+	return logfmtimpl.LoggerProvider()
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wlogtmpl
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
+	"gopkg.in/yaml.v2"
+)
+
+// FileConfig is the YAML representation of a Config. For example:
+//
+//	only: [service.1, request.2]
+//	theme: levels
+//	layout:
+//	  multiline-params: true
+//	  collapse-stack-frames: true
+//	types:
+//	  service.1:
+//	    template: '{{.Level}} {{.Origin}}: {{.Message}}'
+//	    colors:
+//	      - level: ERROR
+//	        color: bold red
+//	      - field: origin
+//	        regex: '^com\.palantir\.'
+//	        color: cyan
+//	  request.2:
+//	    colors:
+//	      - field: status
+//	        regex: '^5'
+//	        color: red
+type FileConfig struct {
+	// Strict sets Config.Strict.
+	Strict bool `yaml:"strict"`
+	// NoSubstitution disables the substitution of slf4j-style "{}" placeholders in service.1 messages.
+	NoSubstitution bool `yaml:"no-substitution"`
+	// Only sets Config.Only.
+	Only []string `yaml:"only"`
+	// Exclude sets Config.Exclude.
+	Exclude []string `yaml:"exclude"`
+	// Theme is the name of the color theme used to colorize entries (see logs.Themes). If empty, the default theme is
+	// used. The color rules of a type take precedence over the theme.
+	Theme string `yaml:"theme"`
+	// Layout configures the layout of formatted entries.
+	Layout LayoutFileConfig `yaml:"layout"`
+	// Types configures the formatters for log types. Types that are not specified use the default formatter.
+	Types map[string]TypeFileConfig `yaml:"types"`
+}
+
+// LayoutFileConfig configures the layout of formatted entries for all log types. See the corresponding
+// logentryformatter params for details.
+type LayoutFileConfig struct {
+	// Width is the maximum width of output lines. If 0, lines are not shortened.
+	Width int `yaml:"width"`
+	// MaxValueWidth is the maximum width of parameter values. If 0, values are not shortened.
+	MaxValueWidth int `yaml:"max-value-width"`
+	// MultilineParams renders every parameter on its own line.
+	MultilineParams bool `yaml:"multiline-params"`
+	// CollapseStackFrames collapses repeated blocks of lines such as recursive stack frames.
+	CollapseStackFrames bool `yaml:"collapse-stack-frames"`
+	// RelativeTime renders times relative to the time of the first formatted entry.
+	RelativeTime bool `yaml:"relative-time"`
+}
+
+func (c LayoutFileConfig) params() []logentryformatter.Param {
+	params := []logentryformatter.Param{
+		logentryformatter.Width(c.Width),
+		logentryformatter.MaxValueWidth(c.MaxValueWidth),
+	}
+	if c.MultilineParams {
+		params = append(params, logentryformatter.MultilineParams())
+	}
+	if c.CollapseStackFrames {
+		params = append(params, logentryformatter.CollapseStackFrames())
+	}
+	if c.RelativeTime {
+		params = append(params, logentryformatter.RelativeTime(time.Time{}))
+	}
+	return params
+}
+
+// TypeFileConfig configures the formatter for a single log type.
+type TypeFileConfig struct {
+	// Template is the template used to render entries of the type. If empty, the default template is used.
+	Template string `yaml:"template"`
+	// Colors are the rules used to colorize entries of the type. The color of the first rule that matches an entry is
+	// used. If any rules are specified, they replace the default colors for the type.
+	Colors []ColorRule `yaml:"colors"`
+}
+
+// ColorRule colorizes the entries that match it. Exactly one of Level or Field must be specified.
+type ColorRule struct {
+	// Level matches entries whose "level" field is equal to the value (case-insensitive).
+	Level string `yaml:"level"`
+	// Field is the dot-separated path of the field of the template object whose value is matched against Regex. The
+	// first element may be the field name or the JSON field name listed by DescribeObject, so both "Origin" and
+	// "origin" are valid, and subsequent elements may be map keys, as in "params.tenant".
+	Field string `yaml:"field"`
+	// Regex is the regular expression that the value of Field must match.
+	Regex string `yaml:"regex"`
+	// Color is a space-separated list of color attributes, such as "red" or "bold hi-yellow bg-blue". Valid attributes
+	// are the colors black, red, green, yellow, blue, magenta, cyan and white, optionally prefixed by "hi-" and/or
+	// "bg-", and the styles bold, faint, italic, underline and blink.
+	Color string `yaml:"color"`
+}
+
+// LoadConfigFile reads the YAML configuration file at the provided path and returns the Config that it specifies.
+// The provided params are used for all of the formatters.
+func LoadConfigFile(path string, params ...logentryformatter.Param) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ParseConfigFile(bytes, params...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// ParseConfigFile parses the provided YAML configuration and returns the Config that it specifies. Unknown keys are
+// rejected, templates and color rule fields are validated against the template object of the log type and color
+// rules must specify valid colors.
+func ParseConfigFile(content []byte, params ...logentryformatter.Param) (*Config, error) {
+	var fileCfg FileConfig
+	if err := yaml.UnmarshalStrict(content, &fileCfg); err != nil {
+		return nil, err
+	}
+	return fileCfg.Config(params...)
+}
+
+// Config validates the FileConfig and returns the Config that it specifies.
+func (c FileConfig) Config(params ...logentryformatter.Param) (*Config, error) {
+	params = append(append([]logentryformatter.Param{}, params...), c.Layout.params()...)
+	if c.NoSubstitution {
+		params = append(params, logentryformatter.NoSubstitution())
+	}
+	if c.Theme != "" {
+		themeParam, err := logs.ThemeParam(c.Theme)
+		if err != nil {
+			return nil, fmt.Errorf("theme: %v", err)
+		}
+		params = append(params, themeParam)
+	}
+	formatters := logs.Formatters(params...)
+
+	// sort types so that errors are deterministic
+	var types []string
+	for typ := range c.Types {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		formatter, err := c.Types[typ].formatter(logentryformatter.LogType(typ), formatters, params)
+		if err != nil {
+			return nil, fmt.Errorf("types.%s: %v", typ, err)
+		}
+		formatters[logentryformatter.LogType(typ)] = formatter
+	}
+
+	only, err := logTypeSet("only", c.Only, formatters)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := logTypeSet("exclude", c.Exclude, formatters)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		Strict:       c.Strict,
+		UnwrapperMap: logs.Unwrappers,
+		FormatterMap: formatters,
+		Only:         only,
+		Exclude:      exclude,
+	}, nil
+}
+
+func (c TypeFileConfig) formatter(typ logentryformatter.LogType, defaults map[logentryformatter.LogType]logentryformatter.Formatter, params []logentryformatter.Param) (logentryformatter.Formatter, error) {
+	tmpl := c.Template
+	if tmpl == "" {
+		defaultFormatter, ok := defaults[typ]
+		if !ok {
+			return nil, fmt.Errorf("template must be specified for log type that does not have a default formatter")
+		}
+		tmpl = defaultFormatter.RawTemplate()
+	}
+	obj, _ := logs.TemplateObject(typ)
+	if err := logentryformatter.ValidateTemplate(tmpl, obj); err != nil {
+		return nil, fmt.Errorf("template: %v", err)
+	}
+
+	if len(c.Colors) > 0 {
+		colorizer, err := newRulesColorizer(c.Colors, obj)
+		if err != nil {
+			return nil, err
+		}
+		params = append(append([]logentryformatter.Param{}, params...), logentryformatter.Colorizer(colorizer))
+	}
+	return logs.Formatter(typ, tmpl, params...)
+}
+
+func logTypeSet(name string, types []string, formatters map[logentryformatter.LogType]logentryformatter.Formatter) (map[logentryformatter.LogType]struct{}, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+	set := make(map[logentryformatter.LogType]struct{}, len(types))
+	for _, typ := range types {
+		if _, ok := formatters[logentryformatter.LogType(typ)]; !ok {
+			return nil, fmt.Errorf("%s: unknown log type %q", name, typ)
+		}
+		set[logentryformatter.LogType(typ)] = struct{}{}
+	}
+	return set, nil
+}
+
+type colorMatcher struct {
+	field string
+	match func(string) bool
+	color *color.Color
+}
+
+func newRulesColorizer(rules []ColorRule, obj interface{}) (logentryformatter.ColorizerFunc, error) {
+	var matchers []colorMatcher
+	for i, rule := range rules {
+		matcher, err := rule.matcher(obj)
+		if err != nil {
+			return nil, fmt.Errorf("colors[%d]: %v", i, err)
+		}
+		matchers = append(matchers, matcher)
+	}
+	return func(in interface{}) *color.Color {
+		for _, m := range matchers {
+			val, ok := logentryformatter.ObjectFieldValue(in, m.field)
+			if ok && m.match(logentryformatter.FormatValue(val)) {
+				return m.color
+			}
+		}
+		return nil
+	}, nil
+}
+
+func (r ColorRule) matcher(obj interface{}) (colorMatcher, error) {
+	c, err := parseColor(r.Color)
+	if err != nil {
+		return colorMatcher{}, err
+	}
+	switch {
+	case r.Level != "" && r.Field == "" && r.Regex == "":
+		if err := logentryformatter.ValidateObjectFieldPath(obj, "level"); err != nil {
+			return colorMatcher{}, fmt.Errorf("level rule cannot be used for log type: %v", err)
+		}
+		level := r.Level
+		return colorMatcher{
+			field: "level",
+			match: func(val string) bool {
+				return strings.EqualFold(val, level)
+			},
+			color: c,
+		}, nil
+	case r.Level == "" && r.Field != "":
+		if err := logentryformatter.ValidateObjectFieldPath(obj, r.Field); err != nil {
+			return colorMatcher{}, err
+		}
+		regex, err := regexp.Compile(r.Regex)
+		if err != nil {
+			return colorMatcher{}, fmt.Errorf("invalid regex: %v", err)
+		}
+		return colorMatcher{
+			field: r.Field,
+			match: regex.MatchString,
+			color: c,
+		}, nil
+	}
+	return colorMatcher{}, fmt.Errorf("exactly one of level or field must be specified, and regex may only be specified with field")
+}
+
+var colorAttributes = map[string]color.Attribute{
+	"bold":      color.Bold,
+	"faint":     color.Faint,
+	"italic":    color.Italic,
+	"underline": color.Underline,
+	"blink":     color.BlinkSlow,
+}
+
+var colorNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+func init() {
+	for i, name := range colorNames {
+		colorAttributes[name] = color.FgBlack + color.Attribute(i)
+		colorAttributes["hi-"+name] = color.FgHiBlack + color.Attribute(i)
+		colorAttributes["bg-"+name] = color.BgBlack + color.Attribute(i)
+		colorAttributes["bg-hi-"+name] = color.BgHiBlack + color.Attribute(i)
+	}
+}
+
+func parseColor(in string) (*color.Color, error) {
+	fields := strings.Fields(in)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("color must be specified")
+	}
+	var attrs []color.Attribute
+	for _, field := range fields {
+		attr, ok := colorAttributes[strings.ToLower(field)]
+		if !ok {
+			return nil, fmt.Errorf("unknown color attribute %q", field)
+		}
+		attrs = append(attrs, attr)
+	}
+	return color.New(attrs...), nil
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wlogtmpl_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wlogtmpl "github.com/palantir/witchcraft-go-logging/wlog-tmpl"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `
+exclude: [metric.1]
+types:
+  service.1:
+    template: '{{.Level}} {{.Origin}}: {{.Message}}'
+    colors:
+      - level: error
+        color: bold red
+      - field: params.tenant
+        regex: '^acme$'
+        color: cyan
+`
+
+func TestParseConfigFile(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() {
+		color.NoColor = noColor
+	}()
+
+	cfg, err := wlogtmpl.ParseConfigFile([]byte(testConfigFile))
+	require.NoError(t, err)
+	assert.Contains(t, cfg.Exclude, logentryformatter.LogType("metric.1"))
+
+	buf := &bytes.Buffer{}
+	logger := svc1log.NewFromCreator(buf, wlog.DebugLevel, wlogtmpl.LoggerProvider(cfg).NewLeveledLogger, svc1log.Origin("origin"))
+	logger.Error("failed")
+	logger.Info("tenant", svc1log.SafeParam("tenant", "acme"))
+	logger.Info("plain")
+	assert.Equal(t, strings.Join([]string{
+		color.New(color.Bold, color.FgRed).Sprint("ERROR origin: failed"),
+		color.New(color.FgCyan).Sprint("INFO origin: tenant"),
+		color.New().Sprint("INFO origin: plain"),
+	}, "\n")+"\n", buf.String())
+}
+
+func TestParseConfigFileLayout(t *testing.T) {
+	cfg, err := wlogtmpl.ParseConfigFile([]byte(`
+theme: none
+layout:
+  multiline-params: true
+  max-value-width: 4
+types:
+  service.1:
+    template: '{{.Message}}{{if .Params}} {{niceMap .Params}}{{end}}'
+`))
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	logger := svc1log.NewFromCreator(buf, wlog.DebugLevel, wlogtmpl.LoggerProvider(cfg).NewLeveledLogger)
+	logger.Info("message", svc1log.SafeParam("key", "value"), svc1log.SafeParam("other", 1))
+	assert.Equal(t, color.New().Sprint("message\n    key: val…\n    other: 1")+"\n", buf.String())
+}
+
+func TestParseConfigFileErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "unknown key",
+			config: `
+typs:
+  service.1: {}`,
+			wantErr: "yaml: unmarshal errors:\n  line 2: field typs not found in type wlogtmpl.FileConfig",
+		},
+		{
+			name: "unknown template field",
+			config: `
+types:
+  service.1:
+    template: '{{.Lvl}}'`,
+			wantErr: `types.service.1: template: template references unknown field ".Lvl": valid fields are Type, Level, Time, Origin, Thread, Message, Params, Uid, Sid, TokenId, TraceId, Stacktrace, UnsafeParams, Tags`,
+		},
+		{
+			name: "unknown color field",
+			config: `
+types:
+  request.2:
+    colors:
+      - field: level
+        color: red`,
+			wantErr: `types.request.2: colors[0]: unknown field "level": valid fields are type, time, method, protocol, path, params, status, requestSize, responseSize, duration, uid, sid, tokenId, traceId, unsafeParams`,
+		},
+		{
+			name: "unknown color",
+			config: `
+types:
+  service.1:
+    colors:
+      - level: ERROR
+        color: bold orange`,
+			wantErr: `types.service.1: colors[0]: unknown color attribute "orange"`,
+		},
+		{
+			name: "invalid rule",
+			config: `
+types:
+  service.1:
+    colors:
+      - level: ERROR
+        field: origin
+        color: red`,
+			wantErr: `types.service.1: colors[0]: exactly one of level or field must be specified, and regex may only be specified with field`,
+		},
+		{
+			name: "custom type without template",
+			config: `
+types:
+  custom.1:
+    colors:
+      - field: name
+        color: red`,
+			wantErr: `types.custom.1: template must be specified for log type that does not have a default formatter`,
+		},
+		{
+			name:    "unknown theme",
+			config:  `theme: rainbow`,
+			wantErr: `theme: unknown theme "rainbow": valid themes are default, levels, mono, none`,
+		},
+		{
+			name: "unknown only type",
+			config: `
+only: [service.2]`,
+			wantErr: `only: unknown log type "service.2"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := wlogtmpl.ParseConfigFile([]byte(tc.config))
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewFileLoggerProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wlog-tmpl.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+types:
+  event.2:
+    template: 'first {{.EventName}}'
+`), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloadErrs := make(chan error, 10)
+	provider, err := wlogtmpl.NewFileLoggerProvider(ctx, path, time.Millisecond, func(err error) {
+		reloadErrs <- err
+	})
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	logger := evt2log.NewFromCreator(buf, provider.NewLogger)
+	logger.Event("my.event")
+	assert.Equal(t, "first my.event\n", buf.String())
+
+	// invalid configuration is reported and the current configuration is kept
+	require.NoError(t, os.WriteFile(path, []byte(`types: {event.2: {template: '{{.Unknown}}'}}`), 0644))
+	select {
+	case err := <-reloadErrs:
+		assert.Contains(t, err.Error(), `template references unknown field ".Unknown"`)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "timed out waiting for reload error")
+	}
+	buf.Reset()
+	logger.Event("my.event")
+	assert.Equal(t, "first my.event\n", buf.String())
+
+	require.NoError(t, os.WriteFile(path, []byte(`types: {event.2: {template: 'second {{.EventName}}'}}`), 0644))
+	assert.Eventually(t, func() bool {
+		buf.Reset()
+		logger.Event("my.event")
+		return buf.String() == "second my.event\n"
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestNewFileLoggerProviderInvalidPollInterval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wlog-tmpl.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`types: {}`), 0644))
+	_, err := wlogtmpl.NewFileLoggerProvider(context.Background(), path, 0, nil)
+	assert.EqualError(t, err, "poll interval must be positive: 0s")
+}
+
+func TestNewFileLoggerProviderClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wlog-tmpl.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`types: {event.2: {template: 'first {{.EventName}}'}}`), 0644))
+	provider, err := wlogtmpl.NewFileLoggerProvider(context.Background(), path, time.Millisecond, nil)
+	require.NoError(t, err)
+	require.NoError(t, wlog.Close(provider))
+
+	// the configuration is no longer reloaded once the provider is closed
+	require.NoError(t, os.WriteFile(path, []byte(`types: {event.2: {template: 'second {{.EventName}}'}}`), 0644))
+	time.Sleep(50 * time.Millisecond)
+	buf := &bytes.Buffer{}
+	evt2log.NewFromCreator(buf, provider.NewLogger).Event("my.event")
+	assert.Equal(t, "first my.event\n", buf.String())
+}
@@ -0,0 +1,224 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template/parse"
+)
+
+// ValidateTemplate verifies that the provided template string is a valid template and that every field that it
+// references on the template object (for example, ".Level" or ".Params.key") exists on the type of obj. Fields
+// referenced within "range" and "with" blocks are not validated because those blocks change the object that "."
+// refers to. If obj is nil, only the syntax of the template is validated.
+func ValidateTemplate(tmplString string, obj interface{}) error {
+	tmpl, err := parseTemplate(tmplString)
+	if err != nil {
+		return err
+	}
+	if obj == nil || tmpl.Tree == nil {
+		return nil
+	}
+	return validateNode(tmpl.Tree.Root, reflect.TypeOf(obj))
+}
+
+func validateNode(node parse.Node, typ reflect.Type) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := validateNode(child, typ); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return validateNode(n.Pipe, typ)
+	case *parse.IfNode:
+		return validateBranch(&n.BranchNode, typ, true)
+	case *parse.RangeNode:
+		return validateBranch(&n.BranchNode, typ, false)
+	case *parse.WithNode:
+		return validateBranch(&n.BranchNode, typ, false)
+	case *parse.TemplateNode:
+		return validateNode(n.Pipe, typ)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := validateNode(cmd, typ); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if err := validateNode(arg, typ); err != nil {
+				return err
+			}
+		}
+	case *parse.FieldNode:
+		if _, err := fieldType(typ, n.Ident); err != nil {
+			return fmt.Errorf("template references unknown field %q: %v", "."+strings.Join(n.Ident, "."), err)
+		}
+	}
+	return nil
+}
+
+// validateBranch validates the pipeline and else list of the branch. The list is only validated if keepsDot is true.
+func validateBranch(n *parse.BranchNode, typ reflect.Type, keepsDot bool) error {
+	if err := validateNode(n.Pipe, typ); err != nil {
+		return err
+	}
+	if keepsDot {
+		if err := validateNode(n.List, typ); err != nil {
+			return err
+		}
+	}
+	return validateNode(n.ElseList, typ)
+}
+
+// fieldType returns the type of the value at the provided path of Go field or method names.
+func fieldType(typ reflect.Type, path []string) (reflect.Type, error) {
+	for _, name := range path {
+		for typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		switch typ.Kind() {
+		case reflect.Map, reflect.Interface:
+			// keys of maps and fields of interfaces cannot be validated statically
+			return nil, nil
+		case reflect.Struct:
+			if field, ok := typ.FieldByName(name); ok && field.PkgPath == "" {
+				typ = field.Type
+				continue
+			}
+			if method, ok := reflect.PtrTo(typ).MethodByName(name); ok {
+				if method.Type.NumOut() == 0 {
+					return nil, fmt.Errorf("method %s of %s does not return a value", name, typ)
+				}
+				typ = method.Type.Out(0)
+				continue
+			}
+			return nil, fmt.Errorf("valid fields are %s", strings.Join(ObjectFieldNames(reflect.Zero(typ).Interface()), ", "))
+		default:
+			return nil, fmt.Errorf("%s has no field %s", typ, name)
+		}
+	}
+	return typ, nil
+}
+
+// ObjectFieldNames returns the names of the exported fields of the provided struct in declaration order. These are the
+// names listed in the "Name" column of the output of DescribeObject. Returns nil if obj is not a struct.
+func ObjectFieldNames(obj interface{}) []string {
+	typ := reflect.TypeOf(obj)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+	var names []string
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.PkgPath == "" {
+			names = append(names, field.Name)
+		}
+	}
+	return names
+}
+
+// ObjectFieldValue returns the value at the provided dot-separated path in obj. The first element of the path may be
+// either the Go name or the JSON name of a field of obj (both are listed in the output of DescribeObject), while the
+// subsequent elements are either Go field names or map keys. Returns false if the path does not exist or traverses a
+// nil value.
+func ObjectFieldValue(obj interface{}, path string) (interface{}, bool) {
+	val := reflect.ValueOf(obj)
+	for _, name := range strings.Split(path, ".") {
+		for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+			if val.IsNil() {
+				return nil, false
+			}
+			val = val.Elem()
+		}
+		switch val.Kind() {
+		case reflect.Struct:
+			field, ok := structFieldByName(val.Type(), name)
+			if !ok {
+				return nil, false
+			}
+			val = val.FieldByIndex(field.Index)
+		case reflect.Map:
+			if val.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			val = val.MapIndex(reflect.ValueOf(name).Convert(val.Type().Key()))
+			if !val.IsValid() {
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, false
+		}
+		val = val.Elem()
+	}
+	if !val.IsValid() || !val.CanInterface() {
+		return nil, false
+	}
+	return val.Interface(), true
+}
+
+// ValidateObjectFieldPath returns an error if the first element of the provided dot-separated path is not the Go name
+// or JSON name of a field of obj. Subsequent elements are not validated because they are typically map keys.
+func ValidateObjectFieldPath(obj interface{}, path string) error {
+	typ := reflect.TypeOf(obj)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+	name := strings.Split(path, ".")[0]
+	if _, ok := structFieldByName(typ, name); ok {
+		return nil
+	}
+	var valid []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if jsonName := structFieldJSONName(&field); jsonName != "" && jsonName != "-" {
+			valid = append(valid, jsonName)
+		} else {
+			valid = append(valid, field.Name)
+		}
+	}
+	return fmt.Errorf("unknown field %q: valid fields are %s", name, strings.Join(valid, ", "))
+}
+
+// structFieldByName returns the exported field of the struct type with the provided Go name or JSON name.
+func structFieldByName(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if field.Name == name || structFieldJSONName(&field) == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter_test
+
+import (
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLevel struct {
+	val string
+}
+
+func (l testLevel) String() string {
+	return l.val
+}
+
+type testObject struct {
+	Level   testLevel              `json:"level"`
+	Message *string                `json:"message"`
+	Params  map[string]interface{} `json:"params"`
+	Nested  struct {
+		Value string
+	} `json:"nested"`
+}
+
+func TestValidateTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		tmpl    string
+		wantErr string
+	}{
+		{
+			name: "valid fields",
+			tmpl: `{{.Level}} {{.Level.String}} {{.Message}} {{.Params.key}} {{.Nested.Value}} {{niceMap .Params}}`,
+		},
+		{
+			name: "fields in range and with are not validated",
+			tmpl: `{{range $k, $v := .Params}}{{.Unknown}}{{end}}{{with .Nested}}{{.Value}}{{else}}{{.Level}}{{end}}`,
+		},
+		{
+			name:    "fields in if are validated",
+			tmpl:    `{{if .Message}}{{.Mesage}}{{end}}`,
+			wantErr: `template references unknown field ".Mesage": valid fields are Level, Message, Params, Nested`,
+		},
+		{
+			name:    "unknown nested field",
+			tmpl:    `{{.Nested.Other}}`,
+			wantErr: `template references unknown field ".Nested.Other": valid fields are Value`,
+		},
+		{
+			name:    "field of non-struct",
+			tmpl:    `{{.Message.Length}}`,
+			wantErr: `template references unknown field ".Message.Length": string has no field Length`,
+		},
+		{
+			name:    "invalid syntax",
+			tmpl:    `{{.Level`,
+			wantErr: `template: logFunc:1: unclosed action`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := logentryformatter.ValidateTemplate(tc.tmpl, testObject{})
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestObjectFieldValue(t *testing.T) {
+	msg := "hello"
+	obj := testObject{
+		Level:   testLevel{val: "WARN"},
+		Message: &msg,
+		Params:  map[string]interface{}{"tenant": "acme"},
+	}
+
+	val, ok := logentryformatter.ObjectFieldValue(obj, "level")
+	assert.True(t, ok)
+	assert.Equal(t, "WARN", logentryformatter.FormatValue(val))
+
+	val, ok = logentryformatter.ObjectFieldValue(obj, "Message")
+	assert.True(t, ok)
+	assert.Equal(t, "hello", val)
+
+	val, ok = logentryformatter.ObjectFieldValue(obj, "params.tenant")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", val)
+
+	_, ok = logentryformatter.ObjectFieldValue(obj, "params.missing")
+	assert.False(t, ok)
+	_, ok = logentryformatter.ObjectFieldValue(testObject{}, "message")
+	assert.False(t, ok)
+
+	assert.NoError(t, logentryformatter.ValidateObjectFieldPath(obj, "params.anything"))
+	assert.EqualError(t, logentryformatter.ValidateObjectFieldPath(obj, "origin"), `unknown field "origin": valid fields are level, message, params, nested`)
+}
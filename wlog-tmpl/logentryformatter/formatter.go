@@ -66,6 +66,56 @@ func FormatLogLine(text string, unwrapperMap map[LogType]Unwrapper, formatterMap
 	return formatter.Format(lineJSON)
 }
 
+// FormatLogEntry returns the human-readable version of the log entry with the provided values. It is equivalent to
+// calling FormatLogLine with the JSON representation of the values, but if the unwrappers and formatters that are used
+// implement ValuesUnwrapper and ValuesFormatter, the entry is formatted without encoding it as JSON.
+func FormatLogEntry(values map[string]interface{}, unwrapperMap map[LogType]Unwrapper, formatterMap map[LogType]Formatter, only, exclude map[LogType]struct{}) (string, error) {
+	logType, err := valuesLogType(values)
+	if err != nil {
+		return "", err
+	}
+	if unwrapper, ok := unwrapperMap[logType]; ok {
+		valuesUnwrapper, ok := unwrapper.(ValuesUnwrapper)
+		if !ok {
+			return formatLogEntryJSON(values, unwrapperMap, formatterMap, only, exclude)
+		}
+		contents, err := valuesUnwrapper.UnwrapLogEntry(values)
+		if err != nil {
+			return "", fmt.Errorf("Failed to unwrap log line type: %s", logType)
+		}
+		values = contents
+		logType, err = valuesLogType(values)
+		if err != nil {
+			return "", err
+		}
+	}
+	if _, exclude := exclude[logType]; exclude {
+		// return empty if log type was specified as exclude
+		return "", nil
+	}
+	if _, include := only[logType]; len(only) > 0 && !include {
+		// return empty if include list is non-empty and this log type is not in the include list
+		return "", nil
+	}
+	formatter, ok := formatterMap[logType]
+	if !ok {
+		return "", fmt.Errorf("Skipping unknown log line type: %s", logType)
+	}
+	valuesFormatter, ok := formatter.(ValuesFormatter)
+	if !ok {
+		return formatLogEntryJSON(values, unwrapperMap, formatterMap, only, exclude)
+	}
+	return valuesFormatter.FormatValues(values)
+}
+
+func formatLogEntryJSON(values map[string]interface{}, unwrapperMap map[LogType]Unwrapper, formatterMap map[LogType]Formatter, only, exclude map[LogType]struct{}) (string, error) {
+	lineJSON, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("Failed to encode log entry as JSON: %v", err)
+	}
+	return FormatLogLine(string(lineJSON), unwrapperMap, formatterMap, only, exclude)
+}
+
 // An Unwrapper unwraps a line of JSON, returning the underlying log entry's
 // contents.
 type Unwrapper interface {
@@ -82,6 +132,13 @@ func (f UnwrapperFunc) UnwrapLogLine(lineJSON []byte) ([]byte, error) {
 	return f(lineJSON)
 }
 
+// A ValuesUnwrapper is an Unwrapper that can also unwrap a log entry that is
+// provided as the map of its values.
+type ValuesUnwrapper interface {
+	Unwrapper
+	UnwrapLogEntry(values map[string]interface{}) (map[string]interface{}, error)
+}
+
 type Formatter interface {
 	// Format takes JSON bytes that represents a single log entry and returns the human-readable version.
 	Format(lineJSON []byte) (string, error)
@@ -92,15 +149,25 @@ type Formatter interface {
 	TemplateObjectDescription() string
 }
 
+// A ValuesFormatter is a Formatter that can also format a log entry that is provided as the map of its values, which
+// avoids encoding the entry as JSON only for Format to parse it again.
+type ValuesFormatter interface {
+	Formatter
+	// FormatValues takes the values of a single log entry and returns the human-readable version.
+	FormatValues(values map[string]interface{}) (string, error)
+}
+
 type ColorizerFunc func(interface{}) *color.Color
 
 type entryFormatter struct {
 	entryParser    func(lineJSON []byte, substitute bool) (interface{}, error)
+	valuesParser   func(values map[string]interface{}, substitute bool) (interface{}, error)
 	colorizer      ColorizerFunc
 	tmpl           *template.Template
 	objDesc        string
 	rawTemplate    string
 	noSubstitution bool
+	layout         layout
 }
 
 func (f *entryFormatter) Format(lineJSON []byte) (string, error) {
@@ -108,7 +175,25 @@ func (f *entryFormatter) Format(lineJSON []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return f.format(obj)
+}
+
+func (f *entryFormatter) FormatValues(values map[string]interface{}) (string, error) {
+	if f.valuesParser == nil {
+		lineJSON, err := json.Marshal(values)
+		if err != nil {
+			return "", fmt.Errorf("Failed to encode log entry as JSON: %v", err)
+		}
+		return f.Format(lineJSON)
+	}
+	obj, err := f.valuesParser(values, !f.noSubstitution)
+	if err != nil {
+		return "", err
+	}
+	return f.format(obj)
+}
 
+func (f *entryFormatter) format(obj interface{}) (string, error) {
 	buf := &bytes.Buffer{}
 	if err := f.tmpl.Execute(buf, obj); err != nil {
 		return "", err
@@ -119,6 +204,7 @@ func (f *entryFormatter) Format(lineJSON []byte) (string, error) {
 	if logText == "" {
 		return logText, nil
 	}
+	logText = f.layout.apply(logText)
 
 	if f.colorizer != nil {
 		if c := f.colorizer(obj); c != nil {
@@ -144,6 +230,14 @@ func getJSONLine(line []byte) ([]byte, error) {
 	return line[jsonStart:], nil
 }
 
+func valuesLogType(values map[string]interface{}) (LogType, error) {
+	typ, ok := values["type"].(string)
+	if !ok {
+		return "", fmt.Errorf("Log entry %v does not have a \"type\" key so its log type cannot be determined", values)
+	}
+	return LogType(typ), nil
+}
+
 func parseLogType(line []byte) (LogType, error) {
 	var res LogEntry
 	if err := json.Unmarshal(line, &res); err != nil {
@@ -0,0 +1,290 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/palantir/pkg/datetime"
+)
+
+const (
+	// ellipsis is appended to values and lines that are shortened to fit within a maximum width.
+	ellipsis = "…"
+	// paramIndent is the indentation of parameters that are rendered one per line.
+	paramIndent = "    "
+	// maxCollapsedBlockLines is the maximum number of lines in a block of repeated lines that is collapsed.
+	maxCollapsedBlockLines = 4
+)
+
+// Width configures the formatter to shorten every line of its output that is longer than the provided number of
+// characters to that number of characters, ending with an ellipsis. A width that is <= 0 means that lines are not
+// shortened. TerminalWidth can be used to determine the width of the terminal that output is written to.
+func Width(width int) Param {
+	return paramFunc(func(f *entryFormatter) {
+		f.layout.width = width
+	})
+}
+
+// MaxValueWidth configures the formatter to shorten the parameter values rendered by the "niceMap" and "niceMapStr"
+// template functions that are longer than the provided number of characters to that number of characters, ending with
+// an ellipsis. A width that is <= 0 means that values are not shortened.
+func MaxValueWidth(width int) Param {
+	return paramFunc(func(f *entryFormatter) {
+		f.layout.maxValueWidth = width
+	})
+}
+
+// MultilineParams configures the "niceMap" and "niceMapStr" template functions to render every parameter on its own
+// indented line below the line of the entry rather than rendering all of the parameters in parentheses.
+func MultilineParams() Param {
+	return paramFunc(func(f *entryFormatter) {
+		f.layout.multilineParams = true
+	})
+}
+
+// CollapseStackFrames configures the formatter to collapse consecutive repetitions of the same block of lines (such as
+// the frames of a recursive call in a stack trace) into the first occurrence of the block followed by a line that
+// states how many more times the block was repeated.
+func CollapseStackFrames() Param {
+	return paramFunc(func(f *entryFormatter) {
+		f.layout.collapseStackFrames = true
+	})
+}
+
+// RelativeTime configures the "formatTime" template function to render times as the duration that has elapsed since
+// the provided start time (for example, "+1.5s") rather than as absolute times. If start is the zero time, the time of
+// the first entry that is formatted is used as the start time. The start time is shared by all of the formatters that
+// the returned Param is applied to, so the same Param should be provided to the formatters of all log types.
+func RelativeTime(start time.Time) Param {
+	rt := &relativeTime{
+		start: start,
+	}
+	return paramFunc(func(f *entryFormatter) {
+		f.layout.relativeTime = rt
+	})
+}
+
+type layout struct {
+	width               int
+	maxValueWidth       int
+	multilineParams     bool
+	collapseStackFrames bool
+	relativeTime        *relativeTime
+}
+
+// funcs returns the template functions whose output depends on the layout.
+func (l layout) funcs() map[string]interface{} {
+	return map[string]interface{}{
+		"niceMap":    l.niceMap,
+		"niceMapStr": l.niceMapStr,
+		"formatTime": l.formatTime,
+	}
+}
+
+func (l layout) niceMap(params map[string]interface{}) string {
+	if len(params) == 0 {
+		return ""
+	}
+	if !l.multilineParams && l.maxValueWidth <= 0 {
+		return NiceMap(params)
+	}
+
+	var sortedKeys []string
+	for k := range params {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	buf := &bytes.Buffer{}
+	if !l.multilineParams {
+		buf.WriteString("(")
+	}
+	for i, k := range sortedKeys {
+		val := Ellipsize(l.maxValueWidth, FormatValue(params[k]))
+		if l.multilineParams {
+			// the line of the entry typically ends with a space that precedes the parameters: this is removed by apply
+			_, _ = fmt.Fprintf(buf, "\n%s%s: %s", paramIndent, k, val)
+			continue
+		}
+		_, _ = fmt.Fprintf(buf, "%s: %s", k, val)
+		if i != len(params)-1 {
+			buf.WriteString(", ")
+		}
+	}
+	if !l.multilineParams {
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+
+func (l layout) niceMapStr(params map[string]string) string {
+	mapIface := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		mapIface[k] = v
+	}
+	return l.niceMap(mapIface)
+}
+
+func (l layout) formatTime(val interface{}) string {
+	if l.relativeTime == nil {
+		return FormatTime(val)
+	}
+	t, ok := timeValue(val)
+	if !ok {
+		return FormatTime(val)
+	}
+	return l.relativeTime.format(t)
+}
+
+// apply applies the layout to the output of a template.
+func (l layout) apply(text string) string {
+	if l.width <= 0 && !l.multilineParams && !l.collapseStackFrames {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	if l.collapseStackFrames {
+		lines = collapseRepeatedLines(lines)
+	}
+	for i, line := range lines {
+		if l.multilineParams {
+			line = strings.TrimRight(line, " ")
+		}
+		lines[i] = Ellipsize(l.width, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// collapseRepeatedLines replaces consecutive repetitions of the same block of lines with a single line that states how
+// many times the block was repeated. Only blocks that occur at least 3 times in a row are collapsed.
+func collapseRepeatedLines(lines []string) []string {
+	var out []string
+	for i := 0; i < len(lines); {
+		blockLen, repeats := repeatedBlock(lines, i)
+		if repeats < 2 {
+			out = append(out, lines[i])
+			i++
+			continue
+		}
+		out = append(out, lines[i:i+blockLen]...)
+		indent := lines[i][:len(lines[i])-len(strings.TrimLeft(lines[i], " \t"))]
+		if blockLen == 1 {
+			out = append(out, fmt.Sprintf("%s... previous line repeated %d more times", indent, repeats))
+		} else {
+			out = append(out, fmt.Sprintf("%s... previous %d lines repeated %d more times", indent, blockLen, repeats))
+		}
+		i += blockLen * (repeats + 1)
+	}
+	return out
+}
+
+// repeatedBlock returns the length of the shortest block of lines starting at start that is immediately repeated and
+// the number of times it is repeated after its first occurrence. Blocks consisting only of blank lines are ignored.
+func repeatedBlock(lines []string, start int) (blockLen, repeats int) {
+	for blockLen = 1; blockLen <= maxCollapsedBlockLines && start+2*blockLen <= len(lines); blockLen++ {
+		if isBlank(lines[start : start+blockLen]) {
+			continue
+		}
+		repeats = 0
+		for next := start + blockLen; next+blockLen <= len(lines) && equalLines(lines[start:start+blockLen], lines[next:next+blockLen]); next += blockLen {
+			repeats++
+		}
+		if repeats > 0 {
+			return blockLen, repeats
+		}
+	}
+	return 0, 0
+}
+
+func isBlank(lines []string) bool {
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func equalLines(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Ellipsize returns s if it is at most width characters long and otherwise returns the first width-1 characters of s
+// followed by an ellipsis. If width is <= 0, s is returned.
+func Ellipsize(width int, s string) string {
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width-1]) + ellipsis
+}
+
+// Truncate returns the first width characters of s. If width is <= 0, s is returned.
+func Truncate(width int, s string) string {
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	return string([]rune(s)[:width])
+}
+
+// FormatTime returns the string representation of the provided time value. This is the default implementation of the
+// "formatTime" template function.
+func FormatTime(val interface{}) string {
+	return fmt.Sprint(val)
+}
+
+func timeValue(val interface{}) (time.Time, bool) {
+	switch v := val.(type) {
+	case datetime.DateTime:
+		return time.Time(v), true
+	case time.Time:
+		return v, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, v)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+type relativeTime struct {
+	mu    sync.Mutex
+	start time.Time
+}
+
+func (rt *relativeTime) format(t time.Time) string {
+	rt.mu.Lock()
+	if rt.start.IsZero() {
+		rt.start = t
+	}
+	start := rt.start
+	rt.mu.Unlock()
+
+	d := t.Sub(start).Round(time.Millisecond)
+	if d < 0 {
+		return "-" + (-d).String()
+	}
+	return "+" + d.String()
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayout(t *testing.T) {
+	const tmpl = `[{{formatTime .time}}] {{.message}}{{if .params}} {{niceMap .params}}{{end}}{{if .stacktrace}}{{println}}{{.stacktrace}}{{end}}`
+	recursiveTrace := strings.Join([]string{
+		"main.main",
+		"\tmain.go:10",
+		"main.recurse",
+		"\tmain.go:20",
+		"main.recurse",
+		"\tmain.go:20",
+		"main.recurse",
+		"\tmain.go:20",
+		"runtime.goexit",
+	}, "\n")
+	for _, tc := range []struct {
+		name   string
+		params []logentryformatter.Param
+		entry  map[string]interface{}
+		want   string
+	}{
+		{
+			name:  "default layout",
+			entry: map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "hello", "params": map[string]interface{}{"b": "2", "a": 1}},
+			want:  "[2020-01-01T00:00:00Z] hello (a: 1, b: 2)",
+		},
+		{
+			name:   "multiline params",
+			params: []logentryformatter.Param{logentryformatter.MultilineParams()},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "hello", "params": map[string]interface{}{"b": "2", "a": 1}},
+			want:   "[2020-01-01T00:00:00Z] hello\n    a: 1\n    b: 2",
+		},
+		{
+			name:   "max value width",
+			params: []logentryformatter.Param{logentryformatter.MaxValueWidth(5)},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "hello", "params": map[string]interface{}{"key": "a long value", "short": "abc"}},
+			want:   "[2020-01-01T00:00:00Z] hello (key: a lo…, short: abc)",
+		},
+		{
+			name:   "width",
+			params: []logentryformatter.Param{logentryformatter.Width(20)},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "hello", "stacktrace": "short\na line that is too long to fit"},
+			want:   "[2020-01-01T00:00:0…\nshort\na line that is too …",
+		},
+		{
+			name:   "collapse stack frames",
+			params: []logentryformatter.Param{logentryformatter.CollapseStackFrames()},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "failed", "stacktrace": recursiveTrace},
+			want:   "[2020-01-01T00:00:00Z] failed\nmain.main\n\tmain.go:10\nmain.recurse\n\tmain.go:20\n... previous 2 lines repeated 2 more times\nruntime.goexit",
+		},
+		{
+			name:   "collapse repeated line",
+			params: []logentryformatter.Param{logentryformatter.CollapseStackFrames()},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "failed", "stacktrace": "a\n\tb\n\tb\n\tb\n\tb\nc"},
+			want:   "[2020-01-01T00:00:00Z] failed\na\n\tb\n\t... previous line repeated 3 more times\nc",
+		},
+		{
+			name:   "lines repeated only twice are not collapsed",
+			params: []logentryformatter.Param{logentryformatter.CollapseStackFrames()},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "failed", "stacktrace": "a\na\nb"},
+			want:   "[2020-01-01T00:00:00Z] failed\na\na\nb",
+		},
+		{
+			name:   "relative time",
+			params: []logentryformatter.Param{logentryformatter.RelativeTime(time.Date(2019, 12, 31, 23, 59, 58, 500000000, time.UTC))},
+			entry:  map[string]interface{}{"time": "2020-01-01T00:00:00Z", "message": "hello"},
+			want:   "[+1.5s] hello",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			formatter, err := logentryformatter.New(mapEntryParser, tmpl, tc.params...)
+			require.NoError(t, err)
+			lineJSON, err := json.Marshal(tc.entry)
+			require.NoError(t, err)
+			out, err := formatter.Format(lineJSON)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, out)
+		})
+	}
+}
+
+func TestRelativeTimeIsSharedByFormatters(t *testing.T) {
+	param := logentryformatter.RelativeTime(time.Time{})
+	first, err := logentryformatter.New(mapEntryParser, `{{formatTime .time}}`, param)
+	require.NoError(t, err)
+	second, err := logentryformatter.New(mapEntryParser, `{{formatTime .time}} second`, param)
+	require.NoError(t, err)
+
+	out, err := first.Format([]byte(`{"time":"2020-01-01T00:00:01Z"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "+0s", out)
+	out, err = second.Format([]byte(`{"time":"2020-01-01T00:01:03.25Z"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "+1m2.25s second", out)
+	out, err = first.Format([]byte(`{"time":"2020-01-01T00:00:00Z"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "-1s", out)
+}
+
+func TestEllipsize(t *testing.T) {
+	assert.Equal(t, "hello", logentryformatter.Ellipsize(0, "hello"))
+	assert.Equal(t, "hello", logentryformatter.Ellipsize(5, "hello"))
+	assert.Equal(t, "hel…", logentryformatter.Ellipsize(4, "hello"))
+	assert.Equal(t, "hé…", logentryformatter.Ellipsize(3, "héllo"))
+	assert.Equal(t, "hell", logentryformatter.Truncate(4, "hello"))
+}
+
+func mapEntryParser(lineJSON []byte, substitute bool) (interface{}, error) {
+	var m map[string]interface{}
+	err := json.Unmarshal(lineJSON, &m)
+	return m, err
+}
@@ -44,6 +44,15 @@ func ObjDesc(objDesc string) Param {
 	})
 }
 
+// ValuesParser configures the function that is used to create the object provided to the template from the values of
+// a log entry when it is formatted using FormatValues. If it is not set, FormatValues encodes the values as JSON and
+// uses the entry parser.
+func ValuesParser(valuesParser func(values map[string]interface{}, substitute bool) (interface{}, error)) Param {
+	return paramFunc(func(f *entryFormatter) {
+		f.valuesParser = valuesParser
+	})
+}
+
 // NoSubstitution configures the formatter in a mode that specifies that no substitution should be performed.
 func NoSubstitution() Param {
 	return paramFunc(func(f *entryFormatter) {
@@ -52,12 +61,7 @@ func NoSubstitution() Param {
 }
 
 func New(entryParser func([]byte, bool) (interface{}, error), tmplString string, params ...Param) (Formatter, error) {
-	tmpl := template.New("logFunc")
-	tmpl.Funcs(map[string]interface{}{
-		"niceMap":    NiceMap,
-		"niceMapStr": niceMapStr,
-	})
-	tmpl, err := tmpl.Parse(tmplString)
+	tmpl, err := parseTemplate(tmplString)
 	if err != nil {
 		return nil, err
 	}
@@ -72,9 +76,22 @@ func New(entryParser func([]byte, bool) (interface{}, error), tmplString string,
 		}
 		p.apply(f)
 	}
+	f.tmpl.Funcs(f.layout.funcs())
 	return f, nil
 }
 
+var templateFuncs = map[string]interface{}{
+	"niceMap":    NiceMap,
+	"niceMapStr": niceMapStr,
+	"formatTime": FormatTime,
+	"ellipsize":  Ellipsize,
+	"truncate":   Truncate,
+}
+
+func parseTemplate(tmplString string) (*template.Template, error) {
+	return template.New("logFunc").Funcs(templateFuncs).Parse(tmplString)
+}
+
 func NiceMap(params map[string]interface{}) string {
 	if len(params) == 0 {
 		return ""
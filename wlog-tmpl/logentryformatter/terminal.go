@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// TerminalWidth returns the number of columns of the terminal that w writes to. If the COLUMNS environment variable is
+// set to a positive integer, its value is used as the width of the terminal. Returns 0 if w is not a terminal or if its
+// width cannot be determined.
+func TerminalWidth(w io.Writer) int {
+	fd, ok := terminalFd(w)
+	if !ok {
+		return 0
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return terminalWidth(fd)
+}
+
+// ColorEnabled returns true if output written to w should be colorized. The following rules are applied in order:
+//
+//   - If the FORCE_COLOR environment variable is set to a non-empty value, colors are enabled unless its value is
+//     "0" or "false".
+//   - If the NO_COLOR environment variable is set to a non-empty value, colors are disabled (see https://no-color.org).
+//   - If the TERM environment variable is "dumb", colors are disabled.
+//   - Otherwise, colors are enabled if w is a terminal.
+func ColorEnabled(w io.Writer) bool {
+	if forceColor := os.Getenv("FORCE_COLOR"); forceColor != "" {
+		return forceColor != "0" && !strings.EqualFold(forceColor, "false")
+	}
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	_, ok := terminalFd(w)
+	return ok
+}
+
+// terminalFd returns the file descriptor of w if w is a file that is a terminal.
+func terminalFd(w io.Writer) (uintptr, bool) {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return 0, false
+	}
+	fd := f.Fd()
+	return fd, isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logentryformatter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColorEnabled(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		forceColor string
+		noColor    string
+		want       bool
+	}{
+		{name: "not a terminal", want: false},
+		{name: "FORCE_COLOR", forceColor: "1", want: true},
+		{name: "FORCE_COLOR takes precedence over NO_COLOR", forceColor: "true", noColor: "1", want: true},
+		{name: "FORCE_COLOR disabled", forceColor: "0", want: false},
+		{name: "NO_COLOR", noColor: "1", want: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("FORCE_COLOR", tc.forceColor)
+			t.Setenv("NO_COLOR", tc.noColor)
+			assert.Equal(t, tc.want, logentryformatter.ColorEnabled(&bytes.Buffer{}))
+		})
+	}
+}
+
+func TestTerminalWidthNotATerminal(t *testing.T) {
+	t.Setenv("COLUMNS", "100")
+	assert.Equal(t, 0, logentryformatter.TerminalWidth(&bytes.Buffer{}))
+}
@@ -15,6 +15,7 @@
 package wlogtmpl
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"time"
@@ -26,10 +27,9 @@ import (
 
 type tmplLogger struct {
 	w   io.Writer
-	cfg *Config
+	cfg func() *Config
 	*wlog.AtomicLogLevel
 
-	delegate   wlog.LoggerCreator
 	bufferPool bytesbuffers.Pool
 }
 
@@ -61,6 +61,10 @@ func (l *tmplLogger) Error(msg string, params ...wlog.Param) {
 	}
 }
 
+func (l *tmplLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
 func (l *tmplLogger) logOutput(params []wlog.Param) {
 	_, _ = fmt.Fprintln(l.w, l.formatOutput(params))
 }
@@ -68,13 +72,36 @@ func (l *tmplLogger) logOutput(params []wlog.Param) {
 func (l *tmplLogger) formatOutput(params []wlog.Param) string {
 	params = append(params, wlog.StringParam(wlog.TimeKey, time.Now().Format(time.RFC3339Nano)))
 
+	cfg := l.cfg()
+	if cfg.DelegateLogger != nil {
+		return l.formatDelegateOutput(cfg, params)
+	}
+
+	entry := wlog.NewMapLogEntry()
+	wlog.ApplyParams(entry, params)
+	values := entry.AllValues()
+	out, err := logentryformatter.FormatLogEntry(values, cfg.UnwrapperMap, cfg.FormatterMap, cfg.Only, cfg.Exclude)
+	if err != nil {
+		if !cfg.Strict {
+			// print the JSON representation of the entry if it can't be formatted
+			lineJSON, _ := json.Marshal(values)
+			return string(lineJSON)
+		}
+		return err.Error()
+	}
+	return out
+}
+
+// formatDelegateOutput formats the entry by using the delegate logger of the provided configuration to write its JSON
+// representation and formatting the resulting line.
+func (l *tmplLogger) formatDelegateOutput(cfg *Config, params []wlog.Param) string {
 	buf := l.bufferPool.Get()
 	defer l.bufferPool.Put(buf)
-	l.delegate(buf).Log(params...)
+	cfg.DelegateLogger.NewLogger(buf).Log(params...)
 
-	out, err := logentryformatter.FormatLogLine(buf.String(), l.cfg.UnwrapperMap, l.cfg.FormatterMap, l.cfg.Only, l.cfg.Exclude)
+	out, err := logentryformatter.FormatLogLine(buf.String(), cfg.UnwrapperMap, cfg.FormatterMap, cfg.Only, cfg.Exclude)
 	if err != nil {
-		if !l.cfg.Strict {
+		if !cfg.Strict {
 			return buf.String()
 		}
 		return err.Error()
@@ -29,10 +29,15 @@ import (
 	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
 	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log/diag1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log/evt2logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log/metric1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log/svc1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/wrappedlog/wrapped1log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -144,3 +149,36 @@ func TestLogger(t *testing.T) {
 		})
 	}
 }
+
+// TestLoggerMatchesDelegateOutput verifies that formatting entries directly from their values renders the same output
+// as formatting the JSON representation of the entries written by a delegate logger.
+func TestLoggerMatchesDelegateOutput(t *testing.T) {
+	timeRegexp := regexp.MustCompile(`[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9:.]+(Z|[+-][0-9]{2}:[0-9]{2})`)
+	logAll := func(provider wlog.LoggerProvider) string {
+		out := &bytes.Buffer{}
+		for _, tc := range svc1logtests.TestCases() {
+			svc1log.NewFromCreator(out, wlog.DebugLevel, provider.NewLeveledLogger, svc1log.Origin(tc.Origin)).Info(tc.Message, tc.LogParams...)
+		}
+		for _, tc := range evt2logtests.TestCases() {
+			evt2log.NewFromCreator(out, provider.NewLogger).Event(tc.EventName, tc.Params()...)
+		}
+		for _, tc := range metric1logtests.TestCases() {
+			metric1log.NewFromCreator(out, provider.NewLogger).Metric(tc.MetricName, tc.MetricType, tc.Params()...)
+		}
+		for _, tc := range diag1logtests.TestCases() {
+			diag1log.NewFromCreator(out, provider.NewLogger).Diagnostic(tc.Diagnostic, diag1log.UnsafeParams(tc.UnsafeParams))
+		}
+		wrapped := wrapped1log.NewFromProvider(out, wlog.DebugLevel, provider, "entity", "1.0.0")
+		for _, tc := range svc1logtests.TestCases() {
+			wrapped.Service(svc1log.Origin(tc.Origin)).Info(tc.Message, tc.LogParams...)
+		}
+		for _, tc := range evt2logtests.TestCases() {
+			wrapped.Event().Event(tc.EventName, tc.Params()...)
+		}
+		return timeRegexp.ReplaceAllString(out.String(), "<time>")
+	}
+
+	want := logAll(wlogtmpl.LoggerProvider(&wlogtmpl.Config{DelegateLogger: wlog.NewJSONMarshalLoggerProvider()}))
+	got := logAll(wlogtmpl.LoggerProvider(nil))
+	assert.Equal(t, strings.Split(want, "\n"), strings.Split(got, "\n"))
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"github.com/palantir/pkg/datetime"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+)
+
+var audit3LogType = &audit3LogTyper{
+	baseLogTyper: baseLogTyper{
+		typ:         "audit.3",
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}} {{.Result}} {{.Name}}{{if .Categories}} [{{range $i, $c := .Categories}}{{if $i}}, {{end}}{{$c}}{{end}}]{{end}}{{if .UID}} uid: {{.UID}}{{end}}{{if .RequestParams}} {{niceMap .RequestParams}}{{end}}{{if .ResultParams}} {{niceMap .ResultParams}}{{end}}`,
+		defaultObj:  auditLogV3{},
+	},
+}
+
+// auditLogV3 is the object provided to audit.3 templates. The conjure definitions do not include audit.3, so only the
+// fields that are useful for rendering are defined.
+type auditLogV3 struct {
+	Type           string                 `json:"type"`
+	Time           datetime.DateTime      `json:"time"`
+	Deployment     string                 `json:"deployment"`
+	Host           string                 `json:"host"`
+	Product        string                 `json:"product"`
+	ProductVersion string                 `json:"productVersion"`
+	Stack          string                 `json:"stack"`
+	Service        string                 `json:"service"`
+	Environment    string                 `json:"environment"`
+	ProducerType   string                 `json:"producerType"`
+	EventID        string                 `json:"eventId"`
+	UserAgent      string                 `json:"userAgent"`
+	Categories     []string               `json:"categories"`
+	Entities       []interface{}          `json:"entities"`
+	Users          []auditUserV3          `json:"users"`
+	Origins        []string               `json:"origins"`
+	SourceOrigin   string                 `json:"sourceOrigin"`
+	RequestID      string                 `json:"requestId"`
+	Sequence       int64                  `json:"sequence"`
+	UID            string                 `json:"uid"`
+	SID            string                 `json:"sid"`
+	TokenID        string                 `json:"tokenId"`
+	OrgID          string                 `json:"orgId"`
+	TraceID        string                 `json:"traceId"`
+	Origin         string                 `json:"origin"`
+	Name           string                 `json:"name"`
+	Result         string                 `json:"result"`
+	RequestParams  map[string]interface{} `json:"requestParams"`
+	ResultParams   map[string]interface{} `json:"resultParams"`
+}
+
+type auditUserV3 struct {
+	UID       string   `json:"uid"`
+	UserName  string   `json:"userName"`
+	FirstName string   `json:"firstName"`
+	LastName  string   `json:"lastName"`
+	Groups    []string `json:"groups"`
+	Roles     []string `json:"roles"`
+	Realm     string   `json:"realm"`
+}
+
+type audit3LogTyper struct {
+	baseLogTyper
+}
+
+func (r *audit3LogTyper) DefaultFormatter(params ...logentryformatter.Param) logentryformatter.Formatter {
+	return r.baseLogTyper.defaultFormatter(r, params...)
+}
+
+func (r *audit3LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
+	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
+}
+
+func (r *audit3LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interface{}, error) {
+	var res auditLogV3
+	if err := safejson.Unmarshal(lineJSON, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *audit3LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res auditLogV3
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
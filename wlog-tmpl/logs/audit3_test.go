@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"testing"
+)
+
+func TestAudit3Logs(t *testing.T) {
+	RunLogTests(t, []LogTest{
+		{
+			name: "Audit log entry",
+			input: []string{
+				`{"type":"audit.3","time":"2017-05-08T21:34:03.571Z","deployment":"deployment-1","host":"host-1","product":"product-1","productVersion":"1.2.3","producerType":"SERVER","eventId":"c7a5ab3f-5b2e-4a36-8c44-1a3b1f2d6e10","categories":["DATA_LOAD","USER_LOGIN"],"entities":[],"users":[{"uid":"user-1","groups":[],"roles":["owner"]}],"origins":[],"requestId":"request-1","sequence":3,"uid":"user-1","sid":null,"name":"PUT_FILE","result":"SUCCESS","requestParams":{"path":"/tmp"},"resultParams":{"size":10}}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.571Z] SUCCESS PUT_FILE [DATA_LOAD, USER_LOGIN] uid: user-1 (path: /tmp) (size: 10)`,
+			},
+		},
+		{
+			name: "Audit log entry without optional fields",
+			input: []string{
+				`{"type":"audit.3","time":"2017-05-08T21:34:03.5Z","producerType":"SERVER","eventId":"c7a5ab3f-5b2e-4a36-8c44-1a3b1f2d6e10","name":"PUT_FILE","result":"UNAUTHORIZED"}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.5Z]   UNAUTHORIZED PUT_FILE`,
+			},
+		},
+	})
+}
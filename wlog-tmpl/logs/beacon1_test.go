@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"testing"
+)
+
+func TestBeacon1Logs(t *testing.T) {
+	RunLogTests(t, []LogTest{
+		{
+			name: "Beacon log entry",
+			input: []string{
+				`{"type":"beacon.1","time":"2017-05-08T21:34:03.571Z","eventType":"compass.SearchEvent.v1","appName":"compass","appVersion":"1.2.3","params":{"resultCount":10},"browserId":"browser-1","uid":null,"sid":null,"traceId":null,"unsafeParams":{"query":"unsafeVal"}}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.571Z] compass 1.2.3 compass.SearchEvent.v1 (resultCount: 10) (query: unsafeVal)`,
+			},
+		},
+		{
+			name: "Beacon log entry without params",
+			input: []string{
+				`{"type":"beacon.1","time":"2017-05-08T21:34:03.5Z","eventType":"compass.PageView.v1","appName":"compass","appVersion":"1.2.3"}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.5Z]   compass 1.2.3 compass.PageView.v1`,
+			},
+		},
+	})
+}
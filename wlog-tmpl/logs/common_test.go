@@ -16,11 +16,14 @@ package logs_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/palantir/pkg/safejson"
 	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
 	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type LogTest struct {
@@ -46,5 +49,15 @@ func (test LogTest) Test(t *testing.T) {
 		}
 		expected := test.output[inputIdx]
 		assert.Equal(t, expected, line, "unexpected output on line %d", inputIdx+1)
+
+		if test.err == nil {
+			// formatting the values of the entry must produce the same output as formatting the line
+			var values map[string]interface{}
+			lineJSON := test.input[inputIdx][strings.Index(test.input[inputIdx], "{"):]
+			require.NoError(t, safejson.Unmarshal([]byte(lineJSON), &values), "invalid JSON on line %d", inputIdx+1)
+			line, err := logentryformatter.FormatLogEntry(values, logs.Unwrappers, logs.Formatters(), nil, nil)
+			assert.NoError(t, err, "error formatting values on line %d", inputIdx+1)
+			assert.Equal(t, expected, line, "unexpected output formatting values on line %d", inputIdx+1)
+		}
 	}
 }
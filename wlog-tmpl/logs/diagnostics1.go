@@ -28,7 +28,7 @@ import (
 var diagnostics1LogType = &diagnostics1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "diagnostic.1",
-		defaultTmpl: `{{printf "%-26s" (printf "[%s]" .Time)}}{{if .ContentOnNewLine}}{{printf "\n"}}{{else}} {{end}}{{if .UnsafeParams}}{{printf "%s\n" (niceMap .UnsafeParams)}}{{end}}{{.SerializedContent}}`,
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}}{{if .ContentOnNewLine}}{{printf "\n"}}{{else}} {{end}}{{if .UnsafeParams}}{{printf "%s\n" (niceMap .UnsafeParams)}}{{end}}{{.SerializedContent}}`,
 		defaultObj:  humanReadableDiagnostic{},
 	},
 }
@@ -42,7 +42,8 @@ func (r *diagnostics1LogTyper) DefaultFormatter(params ...logentryformatter.Para
 }
 
 func (r *diagnostics1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -77,6 +78,18 @@ func (r *diagnostics1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (
 	if err := safejson.Unmarshal(lineJSON, &res); err != nil {
 		return nil, err
 	}
+	return newHumanReadableDiagnostic(res)
+}
+
+func (r *diagnostics1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.DiagnosticLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return newHumanReadableDiagnostic(res)
+}
+
+func newHumanReadableDiagnostic(res logging.DiagnosticLogV1) (interface{}, error) {
 	diagnostic := humanReadableDiagnostic{UnsafeParams: res.UnsafeParams, Time: res.Time}
 
 	if err := res.Diagnostic.Accept(&diagnostic); err != nil {
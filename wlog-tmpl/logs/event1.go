@@ -23,7 +23,7 @@ import (
 var evt1LogType = &evt1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "event.1",
-		defaultTmpl: `{{printf "%-26s" (printf "[%s]" .Time)}} {{.EventName}} {{.EventType}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}} {{.EventName}} {{.EventType}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
 		defaultObj:  logging.EventLogV1{},
 	},
 }
@@ -37,7 +37,8 @@ func (r *evt1LogTyper) DefaultFormatter(params ...logentryformatter.Param) logen
 }
 
 func (r *evt1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -48,3 +49,11 @@ func (r *evt1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interfac
 	}
 	return res, nil
 }
+
+func (r *evt1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.EventLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -23,7 +23,7 @@ import (
 var evt2LogType = &evt2LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "event.2",
-		defaultTmpl: `{{printf "%-26s" (printf "[%s]" .Time)}} {{.EventName}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}} {{.EventName}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
 		defaultObj:  logging.EventLogV2{},
 	},
 }
@@ -37,7 +37,8 @@ func (r *evt2LogTyper) DefaultFormatter(params ...logentryformatter.Param) logen
 }
 
 func (r *evt2LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -48,3 +49,11 @@ func (r *evt2LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interfac
 	}
 	return res, nil
 }
+
+func (r *evt2LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.EventLogV2
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -23,6 +23,7 @@ type logTyper interface {
 	DefaultFormatter(params ...logentryformatter.Param) logentryformatter.Formatter
 	NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error)
 	parseLogEntry(lineJSON []byte, substitute bool) (interface{}, error)
+	templateObject() interface{}
 }
 
 type baseLogTyper struct {
@@ -35,6 +36,10 @@ func (b *baseLogTyper) LogType() logentryformatter.LogType {
 	return b.typ
 }
 
+func (b *baseLogTyper) templateObject() interface{} {
+	return b.defaultObj
+}
+
 func (b *baseLogTyper) defaultFormatter(typer logTyper, params ...logentryformatter.Param) logentryformatter.Formatter {
 	fmtr, err := typer.NewFormatter(b.defaultTmpl, params...)
 	if err != nil {
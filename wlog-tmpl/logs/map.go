@@ -32,6 +32,8 @@ var formatters = []logTyper{
 	trace1LogType,
 	req1LogType,
 	diagnostics1LogType,
+	beacon1LogType,
+	audit3LogType,
 }
 
 func OrderedLogTypes() []logentryformatter.LogType {
@@ -50,6 +52,17 @@ func Formatters(params ...logentryformatter.Param) map[logentryformatter.LogType
 	return fmtrs
 }
 
+// TemplateObject returns a zero value of the object that is provided to the templates of the formatters for the
+// provided log type. Returns false if the log type is not one of the built-in types.
+func TemplateObject(typ logentryformatter.LogType) (interface{}, bool) {
+	for _, fmtr := range formatters {
+		if fmtr.LogType() == typ {
+			return fmtr.templateObject(), true
+		}
+	}
+	return nil, false
+}
+
 func Formatter(typ logentryformatter.LogType, tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
 	for _, fmtr := range formatters {
 		if fmtr.LogType() == typ {
@@ -61,5 +74,7 @@ func Formatter(typ logentryformatter.LogType, tmpl string, params ...logentryfor
 		var m map[string]interface{}
 		err := safejson.Unmarshal(lineJSON, &m)
 		return m, err
-	}, tmpl)
+	}, tmpl, logentryformatter.ValuesParser(func(values map[string]interface{}, substitute bool) (interface{}, error) {
+		return plainValues(values)
+	}))
 }
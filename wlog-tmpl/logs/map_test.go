@@ -0,0 +1,35 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTemplatesAreValid(t *testing.T) {
+	formatters := logs.Formatters()
+	for _, typ := range logs.OrderedLogTypes() {
+		obj, ok := logs.TemplateObject(typ)
+		require.True(t, ok, typ)
+		assert.NoError(t, logentryformatter.ValidateTemplate(formatters[typ].RawTemplate(), obj), typ)
+	}
+	_, ok := logs.TemplateObject("unknown.1")
+	assert.False(t, ok)
+}
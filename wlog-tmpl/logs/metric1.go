@@ -23,7 +23,7 @@ import (
 var metric1LogType = &metric1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "metric.1",
-		defaultTmpl: `{{printf "%-26s" (printf "[%s]" .Time)}} METRIC {{.MetricName}} {{.MetricType}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .Tags}} {{niceMapStr .Tags}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}} METRIC {{.MetricName}} {{.MetricType}}{{if .Values}} {{niceMap .Values}}{{end}}{{if .Tags}} {{niceMapStr .Tags}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}`,
 		defaultObj:  logging.MetricLogV1{},
 	},
 }
@@ -37,7 +37,8 @@ func (r *metric1LogTyper) DefaultFormatter(params ...logentryformatter.Param) lo
 }
 
 func (r *metric1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -48,3 +49,11 @@ func (r *metric1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (inter
 	}
 	return res, nil
 }
+
+func (r *metric1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.MetricLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
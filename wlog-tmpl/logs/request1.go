@@ -26,7 +26,7 @@ import (
 var req1LogType = &req1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "request.1",
-		defaultTmpl: `{{with $time := .Time | printf "[%s]"}}{{if le (len $time) 26 }}{{printf "%-26s" $time}}{{else}}{{printf "%-32s" $time}}{{end}}{{end}} "{{if .Method}}{{.Method}} {{end}}{{.Path}} {{.Protocol}}" {{.Status}} {{.ResponseSize}} {{.Duration}}`,
+		defaultTmpl: `{{with $time := formatTime .Time | printf "[%s]"}}{{if le (len $time) 26 }}{{printf "%-26s" $time}}{{else}}{{printf "%-32s" $time}}{{end}}{{end}} "{{if .Method}}{{.Method}} {{end}}{{.Path}} {{.Protocol}}" {{.Status}} {{.ResponseSize}} {{.Duration}}`,
 		defaultObj:  logging.RequestLogV1{},
 	},
 }
@@ -40,7 +40,8 @@ func (r *req1LogTyper) DefaultFormatter(params ...logentryformatter.Param) logen
 }
 
 func (r *req1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
@@ -56,6 +57,17 @@ func (r *req1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interfac
 	return res, nil
 }
 
+func (r *req1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.RequestLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	if substitute {
+		performRequest1PathParamSubstitution(&res)
+	}
+	return res, nil
+}
+
 var requestParamRegexp = regexp.MustCompile(`{[^{}]+}`)
 var colonOrAsterix = regexp.MustCompile(`:|\*`)
 
@@ -25,7 +25,7 @@ import (
 var req2LogType = &req2LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "request.2",
-		defaultTmpl: `{{with $time := .Time | printf "[%s]"}}{{if le (len $time) 26 }}{{printf "%-26s" $time}}{{else}}{{printf "%-32s" $time}}{{end}}{{end}} "{{if .Method}}{{.Method}} {{end}}{{.Path}} {{.Protocol}}" {{.Status}} {{.ResponseSize}} {{.Duration}}`,
+		defaultTmpl: `{{with $time := formatTime .Time | printf "[%s]"}}{{if le (len $time) 26 }}{{printf "%-26s" $time}}{{else}}{{printf "%-32s" $time}}{{end}}{{end}} "{{if .Method}}{{.Method}} {{end}}{{.Path}} {{.Protocol}}" {{.Status}} {{.ResponseSize}} {{.Duration}}`,
 		defaultObj:  logging.RequestLogV2{},
 	},
 }
@@ -39,7 +39,8 @@ func (r *req2LogTyper) DefaultFormatter(params ...logentryformatter.Param) logen
 }
 
 func (r *req2LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -54,6 +55,17 @@ func (r *req2LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interfac
 	return res, nil
 }
 
+func (r *req2LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.RequestLogV2
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	if substitute {
+		performRequest2PathParamSubstitution(&res)
+	}
+	return res, nil
+}
+
 func performRequest2PathParamSubstitution(logEntry *logging.RequestLogV2) {
 	logEntry.Path = requestParamRegexp.ReplaceAllStringFunc(logEntry.Path, func(match string) string {
 		rv := match
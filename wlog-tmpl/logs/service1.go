@@ -28,7 +28,7 @@ import (
 var svc1LogType = &svc1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "service.1",
-		defaultTmpl: `{{printf "%-5s" .Level}} {{printf "%-26s" (printf "[%s]" .Time)}}{{if .Origin}} {{.Origin}}:{{end}} {{.Message}}{{if .Params}} {{niceMap .Params}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}{{if .Stacktrace}}{{println}}{{.Stacktrace}}{{end}}`,
+		defaultTmpl: `{{printf "%-5s" .Level}} {{printf "%-26s" (printf "[%s]" (formatTime .Time))}}{{if .Origin}} {{.Origin}}:{{end}} {{.Message}}{{if .Params}} {{niceMap .Params}}{{end}}{{if .UnsafeParams}} {{niceMap .UnsafeParams}}{{end}}{{if .Stacktrace}}{{println}}{{.Stacktrace}}{{end}}`,
 		defaultObj:  logging.ServiceLogV1{},
 	},
 }
@@ -42,7 +42,7 @@ func (r *svc1LogTyper) DefaultFormatter(params ...logentryformatter.Param) logen
 }
 
 func (r *svc1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.Colorizer(ServiceLogLevelColorer))
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues), logentryformatter.Colorizer(ServiceLogLevelColorer))
 	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
@@ -58,6 +58,17 @@ func (r *svc1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interfac
 	return res, nil
 }
 
+func (r *svc1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.ServiceLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	if substitute {
+		performRenderSubstitution(&res)
+	}
+	return res, nil
+}
+
 var blankPlaceholderRegex = regexp.MustCompile(`{}`)
 
 var namedPlaceholderRegex = regexp.MustCompile(`{[^{}]+}`)
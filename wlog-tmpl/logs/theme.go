@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+)
+
+// DefaultTheme is the name of the theme that is used by the default formatters.
+const DefaultTheme = "default"
+
+// Theme specifies the colors of formatted log entries. A nil color means that entries are not colorized.
+type Theme struct {
+	// Levels are the colors of service.1 entries based on their level.
+	Levels map[logging.LogLevel_Value]*color.Color
+	// ClientError is the color of request.1 and request.2 entries with a 4xx status.
+	ClientError *color.Color
+	// ServerError is the color of request.1 and request.2 entries with a 5xx status.
+	ServerError *color.Color
+}
+
+// Themes are the themes that can be selected by name using ThemeParam.
+var Themes = map[string]Theme{
+	// default only highlights warnings and errors.
+	DefaultTheme: {
+		Levels: logLevelColors,
+	},
+	// levels uses a different color for every level and also highlights failed requests.
+	"levels": {
+		Levels: map[logging.LogLevel_Value]*color.Color{
+			logging.LogLevel_TRACE: color.New(color.Faint),
+			logging.LogLevel_DEBUG: color.New(color.FgBlue),
+			logging.LogLevel_INFO:  color.New(color.FgGreen),
+			logging.LogLevel_WARN:  color.New(color.FgYellow),
+			logging.LogLevel_ERROR: color.New(color.FgRed),
+			logging.LogLevel_FATAL: color.New(color.Bold, color.FgHiRed),
+		},
+		ClientError: color.New(color.FgYellow),
+		ServerError: color.New(color.FgRed),
+	},
+	// mono only uses text attributes, which is suitable for terminals with unknown or low-contrast color schemes.
+	"mono": {
+		Levels: map[logging.LogLevel_Value]*color.Color{
+			logging.LogLevel_TRACE: color.New(color.Faint),
+			logging.LogLevel_DEBUG: color.New(color.Faint),
+			logging.LogLevel_WARN:  color.New(color.Underline),
+			logging.LogLevel_ERROR: color.New(color.Bold),
+			logging.LogLevel_FATAL: color.New(color.Bold, color.Underline),
+		},
+		ServerError: color.New(color.Bold),
+	},
+	// none does not colorize entries.
+	"none": {},
+}
+
+// ThemeNames returns the sorted names of the themes in Themes.
+func ThemeNames() []string {
+	var names []string
+	for name := range Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThemeParam returns a Param that configures formatters to colorize entries using the theme in Themes with the provided
+// name. Returns an error if there is no such theme.
+func ThemeParam(name string) (logentryformatter.Param, error) {
+	theme, ok := Themes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q: valid themes are %s", name, strings.Join(ThemeNames(), ", "))
+	}
+	return logentryformatter.Colorizer(theme.Colorizer), nil
+}
+
+// Colorizer returns the color of the provided template object according to the theme. It can be used as a
+// logentryformatter.ColorizerFunc.
+func (t Theme) Colorizer(in interface{}) *color.Color {
+	switch entry := in.(type) {
+	case logging.ServiceLogV1:
+		return t.Levels[entry.Level.Value()]
+	case logging.RequestLogV2:
+		return t.statusColor(entry.Status)
+	case logging.RequestLogV1:
+		return t.statusColor(entry.Status)
+	}
+	return nil
+}
+
+func (t Theme) statusColor(status int) *color.Color {
+	switch {
+	case status >= 500:
+		return t.ServerError
+	case status >= 400:
+		return t.ClientError
+	}
+	return nil
+}
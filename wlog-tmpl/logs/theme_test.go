@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs_test
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThemes(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() {
+		color.NoColor = noColor
+	}()
+
+	const (
+		svc1Debug = `{"type":"service.1","time":"2020-01-01T00:00:00Z","level":"DEBUG","message":"debug"}`
+		svc1Error = `{"type":"service.1","time":"2020-01-01T00:00:00Z","level":"ERROR","message":"error"}`
+		req2Error = `{"type":"request.2","time":"2020-01-01T00:00:00Z","method":"GET","path":"/","protocol":"HTTP/1.1","status":503,"requestSize":0,"responseSize":0,"duration":10}`
+
+		debugText = "DEBUG [2020-01-01T00:00:00Z]     debug"
+		errorText = "ERROR [2020-01-01T00:00:00Z]     error"
+		req2Text  = `[2020-01-01T00:00:00Z]     "GET / HTTP/1.1" 503 0 10`
+	)
+	for _, tc := range []struct {
+		theme string
+		line  string
+		text  string
+		want  *color.Color
+	}{
+		{theme: logs.DefaultTheme, line: svc1Debug, text: debugText, want: color.New()},
+		{theme: logs.DefaultTheme, line: svc1Error, text: errorText, want: color.New(color.FgRed)},
+		{theme: logs.DefaultTheme, line: req2Error, text: req2Text, want: color.New()},
+		{theme: "levels", line: svc1Debug, text: debugText, want: color.New(color.FgBlue)},
+		{theme: "levels", line: req2Error, text: req2Text, want: color.New(color.FgRed)},
+		{theme: "mono", line: svc1Error, text: errorText, want: color.New(color.Bold)},
+		{theme: "none", line: svc1Error, text: errorText, want: color.New()},
+	} {
+		t.Run(tc.theme, func(t *testing.T) {
+			param, err := logs.ThemeParam(tc.theme)
+			require.NoError(t, err)
+			got, err := logentryformatter.FormatLogLine(tc.line, logs.Unwrappers, logs.Formatters(param), nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want.Sprint(tc.text), got)
+		})
+	}
+
+	_, err := logs.ThemeParam("rainbow")
+	assert.EqualError(t, err, `unknown theme "rainbow": valid themes are default, levels, mono, none`)
+}
@@ -23,7 +23,7 @@ import (
 var trace1LogType = &trace1LogTyper{
 	baseLogTyper: baseLogTyper{
 		typ:         "trace.1",
-		defaultTmpl: `{{printf "%-26s" (printf "[%s]" .Time)}} traceId: {{.Span.TraceId}} id: {{.Span.Id}} name: {{.Span.Name}} duration: {{printf "%d microseconds" .Span.Duration}}`,
+		defaultTmpl: `{{printf "%-26s" (printf "[%s]" (formatTime .Time))}} traceId: {{.Span.TraceId}} id: {{.Span.Id}} name: {{.Span.Name}} duration: {{printf "%d microseconds" .Span.Duration}}`,
 		defaultObj:  logging.TraceLogV1{},
 	},
 }
@@ -37,7 +37,8 @@ func (r *trace1LogTyper) DefaultFormatter(params ...logentryformatter.Param) log
 }
 
 func (r *trace1LogTyper) NewFormatter(tmpl string, params ...logentryformatter.Param) (logentryformatter.Formatter, error) {
-	newParams := append(r.baseLogTyper.baseParams(), params...)
+	newParams := append(r.baseLogTyper.baseParams(), logentryformatter.ValuesParser(r.parseLogValues))
+	newParams = append(newParams, params...)
 	return logentryformatter.New(r.parseLogEntry, tmpl, newParams...)
 }
 
@@ -48,3 +49,11 @@ func (r *trace1LogTyper) parseLogEntry(lineJSON []byte, substitute bool) (interf
 	}
 	return res, nil
 }
+
+func (r *trace1LogTyper) parseLogValues(values map[string]interface{}, substitute bool) (interface{}, error) {
+	var res logging.TraceLogV1
+	if err := decodeValues(values, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
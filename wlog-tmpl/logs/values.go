@@ -0,0 +1,210 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/palantir/pkg/safejson"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// decodeValues stores the provided log entry values in the struct pointed to by out. The result is the same as
+// encoding the values as JSON and unmarshalling the JSON into out, but values that can be stored directly are not
+// encoded, so the cost of formatting an entry that was created by a logger is much lower than the cost of formatting
+// an entry that was read from a JSON log line.
+func decodeValues(values map[string]interface{}, out interface{}) error {
+	return decodeStruct(values, reflect.ValueOf(out).Elem())
+}
+
+func decodeStruct(values map[string]interface{}, out reflect.Value) error {
+	for name, idx := range jsonFields(out.Type()) {
+		v, ok := values[name]
+		if !ok {
+			continue
+		}
+		if err := decodeValue(v, out.Field(idx)); err != nil {
+			return fmt.Errorf("failed to decode field %q: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func decodeValue(v interface{}, out reflect.Value) error {
+	if v == nil {
+		return nil
+	}
+	typ := out.Type()
+	if s, ok := v.(string); ok {
+		if reflect.PtrTo(typ).Implements(textUnmarshalerType) {
+			return out.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+		}
+		if typ.Kind() == reflect.String {
+			out.SetString(s)
+			return nil
+		}
+	}
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch n := v.(type) {
+		case int64:
+			out.SetInt(n)
+			return nil
+		case int32:
+			out.SetInt(int64(n))
+			return nil
+		case int:
+			out.SetInt(int64(n))
+			return nil
+		}
+	case reflect.Interface:
+		if isPlainValue(v) {
+			out.Set(reflect.ValueOf(v))
+			return nil
+		}
+		return decodeJSON(v, out)
+	case reflect.Map:
+		if m, ok := v.(map[string]interface{}); ok && typ == reflect.TypeOf(m) {
+			plain, err := plainValues(m)
+			if err != nil {
+				return err
+			}
+			out.Set(reflect.ValueOf(plain))
+			return nil
+		}
+		if m, ok := v.(map[string]string); ok && typ == reflect.TypeOf(m) {
+			out.Set(reflect.ValueOf(m))
+			return nil
+		}
+	case reflect.Ptr:
+		elem := reflect.New(typ.Elem())
+		if err := decodeValue(v, elem.Elem()); err != nil {
+			return err
+		}
+		out.Set(elem)
+		return nil
+	}
+	if reflect.PtrTo(typ).Implements(jsonUnmarshalerType) {
+		return decodeJSON(v, out)
+	}
+	switch typ.Kind() {
+	case reflect.Struct:
+		if m, ok := v.(map[string]interface{}); ok {
+			return decodeStruct(m, out)
+		}
+	case reflect.Slice:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+			slice := reflect.MakeSlice(typ, rv.Len(), rv.Len())
+			for i := 0; i < rv.Len(); i++ {
+				if err := decodeValue(rv.Index(i).Interface(), slice.Index(i)); err != nil {
+					return err
+				}
+			}
+			out.Set(slice)
+			return nil
+		}
+	}
+	return decodeJSON(v, out)
+}
+
+// decodeJSON stores v in out by encoding it as JSON and unmarshalling the JSON into out.
+func decodeJSON(v interface{}, out reflect.Value) error {
+	jsonBytes, err := safejson.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return safejson.Unmarshal(jsonBytes, out.Addr().Interface())
+}
+
+// isPlainValue returns true if v is a value that JSON unmarshalling into an interface{} could produce (or the
+// equivalent of one), which means that it is rendered the same way as the JSON representation of the value would be.
+func isPlainValue(v interface{}) bool {
+	switch val := v.(type) {
+	case nil, string, bool, json.Number, float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, []string, map[string]string:
+		return true
+	case []interface{}:
+		for _, elem := range val {
+			if !isPlainValue(elem) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		for _, elem := range val {
+			if !isPlainValue(elem) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// plainValues returns values if all of its values are plain, and otherwise a copy of values in which the values that
+// are not plain are replaced with their JSON representation.
+func plainValues(values map[string]interface{}) (map[string]interface{}, error) {
+	if isPlainValue(values) {
+		return values, nil
+	}
+	out := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if isPlainValue(v) {
+			out[k] = v
+			continue
+		}
+		var plain interface{}
+		if err := decodeJSON(v, reflect.ValueOf(&plain).Elem()); err != nil {
+			return nil, err
+		}
+		out[k] = plain
+	}
+	return out, nil
+}
+
+var jsonFieldsCache sync.Map
+
+// jsonFields returns a map from the JSON name of each of the exported fields of the provided struct type to the index
+// of the field.
+func jsonFields(typ reflect.Type) map[string]int {
+	if fields, ok := jsonFieldsCache.Load(typ); ok {
+		return fields.(map[string]int)
+	}
+	fields := make(map[string]int)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		fields[name] = i
+	}
+	jsonFieldsCache.Store(typ, fields)
+	return fields
+}
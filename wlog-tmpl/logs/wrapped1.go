@@ -21,7 +21,26 @@ import (
 	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
 )
 
-var wrapped1Unwrapper = logentryformatter.UnwrapperFunc(unwrapWrappedV1)
+var wrapped1Unwrapper logentryformatter.ValuesUnwrapper = wrapped1LogUnwrapper{}
+
+type wrapped1LogUnwrapper struct{}
+
+func (wrapped1LogUnwrapper) UnwrapLogLine(lineJSON []byte) ([]byte, error) {
+	return unwrapWrappedV1(lineJSON)
+}
+
+func (wrapped1LogUnwrapper) UnwrapLogEntry(values map[string]interface{}) (map[string]interface{}, error) {
+	payload, ok := values["payload"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("wrapped.1 entry does not have a payload")
+	}
+	payloadType, _ := payload["type"].(string)
+	contents, ok := payload[payloadType].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("wrapped.1 entry does not have a %q payload", payloadType)
+	}
+	return contents, nil
+}
 
 func unwrapWrappedV1(lineJSON []byte) ([]byte, error) {
 	var unwrapped wrappedLogV1Payload
@@ -44,11 +63,13 @@ func (p *wrappedLogV1Payload) UnmarshalJSON(data []byte) error {
 	type payload struct {
 		Type            logentryformatter.LogType `json:"type"`
 		ServiceLogV1    json.RawMessage           `json:"serviceLogV1"`
+		RequestLogV1    json.RawMessage           `json:"requestLogV1"`
 		RequestLogV2    json.RawMessage           `json:"requestLogV2"`
 		TraceLogV1      json.RawMessage           `json:"traceLogV1"`
 		EventLogV2      json.RawMessage           `json:"eventLogV2"`
 		MetricLogV1     json.RawMessage           `json:"metricLogV1"`
 		AuditLogV2      json.RawMessage           `json:"auditLogV2"`
+		AuditLogV3      json.RawMessage           `json:"auditLogV3"`
 		DiagnosticLogV1 json.RawMessage           `json:"diagnosticLogV1"`
 	}
 	var wrapped1LogEntry struct {
@@ -61,6 +82,8 @@ func (p *wrappedLogV1Payload) UnmarshalJSON(data []byte) error {
 	default:
 	case "serviceLogV1":
 		p.Contents = wrapped1LogEntry.ServiceLogV1
+	case "requestLogV1":
+		p.Contents = wrapped1LogEntry.RequestLogV1
 	case "requestLogV2":
 		p.Contents = wrapped1LogEntry.RequestLogV2
 	case "traceLogV1":
@@ -71,6 +94,8 @@ func (p *wrappedLogV1Payload) UnmarshalJSON(data []byte) error {
 		p.Contents = wrapped1LogEntry.MetricLogV1
 	case "auditLogV2":
 		p.Contents = wrapped1LogEntry.AuditLogV2
+	case "auditLogV3":
+		p.Contents = wrapped1LogEntry.AuditLogV3
 	case "diagnosticLogV1":
 		p.Contents = wrapped1LogEntry.DiagnosticLogV1
 	}
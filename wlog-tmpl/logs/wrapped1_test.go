@@ -29,6 +29,24 @@ func TestWrapped1Logs(t *testing.T) {
 				`ERROR [2017-04-12T17:41:07.744Z] com.palantir.remoting2.servers.jersey.JsonExceptionMapper: Error handling request 8df8ace6-a068-4094-a7ff-0273469302f5 (0: 8df8ace6-a068-4094-a7ff-0273469302f5, throwableMessage: <nil>)`,
 			},
 		},
+		{
+			name: "Wrapped audit.3 log",
+			input: []string{
+				`{"type":"wrapped.1","payload":{"type":"auditLogV3","auditLogV3":{"type":"audit.3","time":"2017-05-08T21:34:03.571Z","producerType":"SERVER","eventId":"c7a5ab3f-5b2e-4a36-8c44-1a3b1f2d6e10","categories":["DATA_LOAD"],"uid":"user-1","name":"PUT_FILE","result":"SUCCESS","requestParams":{},"resultParams":{}}},"entityName":"codex-hub","entityVersion":"v2.1.0"}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.571Z] SUCCESS PUT_FILE [DATA_LOAD] uid: user-1`,
+			},
+		},
+		{
+			name: "Wrapped request.1 log",
+			input: []string{
+				`{"type":"wrapped.1","payload":{"type":"requestLogV1","requestLogV1":{"type":"request.1","time":"2017-05-08T21:34:03.571Z","method":"GET","protocol":"HTTP/2.0","path":"/long/{id}","pathParams":{"id":25},"queryParams":{},"headerParams":{"accept-encoding":"gzip","host":"localhost:8443","user-agent":"okhttp/3.5.0"},"status":200,"requestSize":"0","responseSize":"108","duration":68000,"uid":null,"sid":null,"tokenId":null,"traceId":"d384560e01f9a657","unsafeParams":{}}},"entityName":"codex-hub","entityVersion":"v2.1.0"}`,
+			},
+			output: []string{
+				`[2017-05-08T21:34:03.571Z] "GET /long/25 HTTP/2.0" 200 108 68000`,
+			},
+		},
 		{
 			name: "Wrapped request.2 log",
 			input: []string{
@@ -15,7 +15,13 @@
 package wlogtmpl
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"io"
+	"os"
+	"sync/atomic"
+	"time"
 
 	"github.com/palantir/pkg/bytesbuffers"
 	"github.com/palantir/witchcraft-go-logging/wlog"
@@ -24,7 +30,11 @@ import (
 )
 
 type tmplLoggerProvider struct {
-	cfg *Config
+	// cfg stores the current *Config. It is an atomic.Value so that the configuration can be reloaded while loggers
+	// created by the provider are in use.
+	cfg atomic.Value
+	// stopReload stops reloading the configuration file of providers created by NewFileLoggerProvider.
+	stopReload context.CancelFunc
 }
 
 type Config struct {
@@ -33,7 +43,8 @@ type Config struct {
 	UnwrapperMap  map[logentryformatter.LogType]logentryformatter.Unwrapper
 	FormatterMap  map[logentryformatter.LogType]logentryformatter.Formatter
 	Only, Exclude map[logentryformatter.LogType]struct{}
-	// DelegateLogger is used to create the intermediate json representation that is passed to the template.
+	// DelegateLogger is used to create the intermediate json representation that is passed to the template. If it is
+	// nil, the objects passed to the templates are created directly from the values of the log entries.
 	DelegateLogger wlog.LoggerProvider
 }
 
@@ -43,6 +54,66 @@ type Config struct {
 //
 // Nil configuration is valid and will result in the default behavior.
 func LoggerProvider(cfg *Config, params ...logentryformatter.Param) wlog.LoggerProvider {
+	p := &tmplLoggerProvider{}
+	p.cfg.Store(withDefaults(cfg, params...))
+	return p
+}
+
+// NewFileLoggerProvider returns a wlog.LoggerProvider that is configured by the YAML configuration file at the
+// provided path (see FileConfig for its format). Returns an error if the file cannot be loaded or if pollInterval is not
+// positive.
+//
+// The file is checked for changes every pollInterval until ctx is done or the provider is closed using wlog.Close. When
+// the content of the file changes, it is loaded and the configuration of the provider and of all of the loggers created
+// by it is updated. If the changed file is not valid, the current configuration is kept and onReloadErr is called with
+// the error if it is non-nil.
+func NewFileLoggerProvider(ctx context.Context, path string, pollInterval time.Duration, onReloadErr func(error), params ...logentryformatter.Param) (wlog.LoggerProvider, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive: %v", pollInterval)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ParseConfigFile(content, params...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	p := &tmplLoggerProvider{stopReload: cancel}
+	p.cfg.Store(withDefaults(cfg, params...))
+
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			newContent, err := os.ReadFile(path)
+			if err == nil && bytes.Equal(newContent, content) {
+				continue
+			}
+			if err == nil {
+				content = newContent
+				var newCfg *Config
+				if newCfg, err = ParseConfigFile(content, params...); err == nil {
+					p.cfg.Store(withDefaults(newCfg, params...))
+					continue
+				}
+				err = fmt.Errorf("invalid configuration file %s: %v", path, err)
+			}
+			if onReloadErr != nil {
+				onReloadErr(err)
+			}
+		}
+	}()
+	return p, nil
+}
+
+func withDefaults(cfg *Config, params ...logentryformatter.Param) *Config {
 	if cfg == nil {
 		cfg = &Config{}
 	}
@@ -60,19 +131,17 @@ func LoggerProvider(cfg *Config, params ...logentryformatter.Param) wlog.LoggerP
 			}
 		}
 	}
-	if cfg.DelegateLogger == nil {
-		cfg.DelegateLogger = wlog.NewJSONMarshalLoggerProvider()
-	}
-	return &tmplLoggerProvider{
-		cfg: cfg,
-	}
+	return cfg
+}
+
+func (p *tmplLoggerProvider) config() *Config {
+	return p.cfg.Load().(*Config)
 }
 
 func (p *tmplLoggerProvider) NewLogger(w io.Writer) wlog.Logger {
 	return &tmplLogger{
 		w:          w,
-		cfg:        p.cfg,
-		delegate:   p.cfg.DelegateLogger.NewLogger,
+		cfg:        p.config,
 		bufferPool: bytesbuffers.NewSyncPool(128),
 	}
 }
@@ -80,9 +149,22 @@ func (p *tmplLoggerProvider) NewLogger(w io.Writer) wlog.Logger {
 func (p *tmplLoggerProvider) NewLeveledLogger(w io.Writer, level wlog.LogLevel) wlog.LeveledLogger {
 	return &tmplLogger{
 		w:              w,
-		cfg:            p.cfg,
+		cfg:            p.config,
 		AtomicLogLevel: wlog.NewAtomicLogLevel(level),
-		delegate:       p.cfg.DelegateLogger.NewLogger,
 		bufferPool:     bytesbuffers.NewSyncPool(128),
 	}
 }
+
+// Flush does nothing: templates are rendered directly to the writers of the loggers that the provider creates, which
+// are flushed by those loggers.
+func (p *tmplLoggerProvider) Flush() error {
+	return nil
+}
+
+// Close stops reloading the configuration file if the provider was created by NewFileLoggerProvider.
+func (p *tmplLoggerProvider) Close() error {
+	if p.stopReload != nil {
+		p.stopReload()
+	}
+	return nil
+}
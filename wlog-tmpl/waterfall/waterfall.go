@@ -0,0 +1,352 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package waterfall renders the spans of trace.1 log entries as waterfall diagrams. Spans are buffered by trace ID and
+// the tree of every trace is reconstructed from the parent IDs of its spans, so spans can be added in any order.
+//
+// For example, a trace with a root span and two child spans is rendered as:
+//
+//	trace 3b2ecfbb0eaf8640 (3 spans, 12ms)
+//	  root                  +0s      12ms  |████████████████████████████████████████|
+//	  ├─ first child       +1ms       5ms  |   █████████████████                    |
+//	  └─ second child      +7ms       4ms  |                       █████████████    |
+//
+// Spans whose parent is not part of the trace are rendered as additional roots that are marked as orphaned.
+package waterfall
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/logentryformatter"
+)
+
+const (
+	defaultBarWidth     = 40
+	defaultMaxNameWidth = 50
+)
+
+type Param interface {
+	apply(*Collector)
+}
+
+type paramFunc func(*Collector)
+
+func (f paramFunc) apply(c *Collector) {
+	f(c)
+}
+
+// BarWidth configures the number of characters used for the bar charts of spans. A width that is <= 0 disables bar
+// charts. The default width is 40.
+func BarWidth(width int) Param {
+	return paramFunc(func(c *Collector) {
+		c.barWidth = width
+	})
+}
+
+// MaxNameWidth configures the maximum number of characters used for the indented name of a span. Longer names are
+// ellipsized. The default width is 50.
+func MaxNameWidth(width int) Param {
+	return paramFunc(func(c *Collector) {
+		c.maxNameWidth = width
+	})
+}
+
+// Collector buffers spans by trace ID and renders them as waterfall diagrams. It is safe for concurrent use.
+type Collector struct {
+	barWidth     int
+	maxNameWidth int
+
+	mu       sync.Mutex
+	traceIDs []string
+	traces   map[string][]logging.Span
+}
+
+// New returns a new Collector configured with the provided params.
+func New(params ...Param) *Collector {
+	c := &Collector{
+		barWidth:     defaultBarWidth,
+		maxNameWidth: defaultMaxNameWidth,
+		traces:       make(map[string][]logging.Span),
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(c)
+	}
+	return c
+}
+
+// Add adds the provided span to the trace with the span's trace ID.
+func (c *Collector) Add(span logging.Span) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.traces[span.TraceId]; !ok {
+		c.traceIDs = append(c.traceIDs, span.TraceId)
+	}
+	c.traces[span.TraceId] = append(c.traces[span.TraceId], span)
+}
+
+// TraceIDs returns the IDs of the traces that have spans in the order in which their first span was added.
+func (c *Collector) TraceIDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.traceIDs...)
+}
+
+// Remove removes the spans of the trace with the provided ID from the collector. This can be used to limit the memory
+// used by the collector once a trace has been rendered.
+func (c *Collector) Remove(traceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.traces[traceID]; !ok {
+		return
+	}
+	delete(c.traces, traceID)
+	for i, id := range c.traceIDs {
+		if id == traceID {
+			c.traceIDs = append(c.traceIDs[:i], c.traceIDs[i+1:]...)
+			break
+		}
+	}
+}
+
+// Render writes the waterfall diagram of the trace with the provided ID to w. Returns an error if the collector does not
+// have any spans for the trace.
+func (c *Collector) Render(w io.Writer, traceID string) error {
+	c.mu.Lock()
+	spans := append([]logging.Span(nil), c.traces[traceID]...)
+	c.mu.Unlock()
+	if len(spans) == 0 {
+		return fmt.Errorf("no spans for trace %s", traceID)
+	}
+	_, err := io.WriteString(w, c.render(traceID, spans))
+	return err
+}
+
+// RenderAll writes the waterfall diagrams of all of the traces to w in the order returned by TraceIDs, separated by
+// blank lines.
+func (c *Collector) RenderAll(w io.Writer) error {
+	for i, traceID := range c.TraceIDs() {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if err := c.Render(w, traceID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type node struct {
+	span logging.Span
+	// orphan is a description of why the node is an orphan. Empty if the node is not an orphan.
+	orphan   string
+	children []*node
+}
+
+type row struct {
+	name string
+	span logging.Span
+	note string
+}
+
+func (c *Collector) render(traceID string, spans []logging.Span) string {
+	roots, nodes := buildTree(spans)
+
+	start, end := spanStart(spans[0]), spanEnd(spans[0])
+	for _, span := range spans[1:] {
+		if s := spanStart(span); s < start {
+			start = s
+		}
+		if e := spanEnd(span); e > end {
+			end = e
+		}
+	}
+
+	var rows []row
+	visited := make(map[*node]struct{}, len(nodes))
+	for _, root := range roots {
+		rows = appendTree(rows, root, visited)
+	}
+	for _, n := range nodes {
+		// spans whose parents form a cycle are not reachable from any root
+		if _, ok := visited[n]; !ok {
+			n.orphan = fmt.Sprintf("orphaned: parent %s is part of a cycle", *n.span.ParentId)
+			rows = appendTree(rows, n, visited)
+		}
+	}
+	nameWidth := 0
+	for i := range rows {
+		rows[i].name = logentryformatter.Ellipsize(c.maxNameWidth, rows[i].name)
+		if width := utf8.RuneCountInString(rows[i].name); width > nameWidth {
+			nameWidth = width
+		}
+	}
+
+	buf := &strings.Builder{}
+	spanCount := "1 span"
+	if len(spans) != 1 {
+		spanCount = fmt.Sprintf("%d spans", len(spans))
+	}
+	_, _ = fmt.Fprintf(buf, "trace %s (%s, %s)\n", traceID, spanCount, microsDuration(end-start))
+	for _, r := range rows {
+		offset := "+" + microsDuration(spanStart(r.span)-start).String()
+		_, _ = fmt.Fprintf(buf, "  %s  %s  %s", padRight(r.name, nameWidth), padLeft(offset, 8), padLeft(microsDuration(int64(r.span.Duration)).String(), 8))
+		if c.barWidth > 0 {
+			_, _ = fmt.Fprintf(buf, "  |%s|", bar(c.barWidth, spanStart(r.span)-start, int64(r.span.Duration), end-start))
+		}
+		if r.note != "" {
+			_, _ = fmt.Fprintf(buf, "  (%s)", r.note)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// buildTree returns the roots of the tree of the provided spans and the nodes for all of the spans. Spans without a
+// parent ID and spans whose parent is not one of the provided spans are roots. Siblings are ordered by start time.
+func buildTree(spans []logging.Span) ([]*node, []*node) {
+	nodes := make(map[string]*node, len(spans))
+	var ordered []*node
+	for _, span := range spans {
+		n := &node{span: span}
+		if _, ok := nodes[span.Id]; !ok {
+			nodes[span.Id] = n
+		}
+		ordered = append(ordered, n)
+	}
+
+	var roots []*node
+	for _, n := range ordered {
+		if n.span.ParentId == nil || *n.span.ParentId == "" {
+			roots = append(roots, n)
+			continue
+		}
+		parent, ok := nodes[*n.span.ParentId]
+		if !ok || parent == n {
+			n.orphan = fmt.Sprintf("orphaned: parent %s not found", *n.span.ParentId)
+			roots = append(roots, n)
+			continue
+		}
+		parent.children = append(parent.children, n)
+	}
+	sortNodes(roots)
+	return roots, ordered
+}
+
+func sortNodes(nodes []*node) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		// non-orphaned roots are rendered before orphaned ones
+		if oi, oj := nodes[i].orphan != "", nodes[j].orphan != ""; oi != oj {
+			return !oi
+		}
+		if si, sj := spanStart(nodes[i].span), spanStart(nodes[j].span); si != sj {
+			return si < sj
+		}
+		return nodes[i].span.Id < nodes[j].span.Id
+	})
+	for _, n := range nodes {
+		sortNodes(n.children)
+	}
+}
+
+// appendTree appends the rows for the tree rooted at n. Nodes that are already in visited are skipped, which guards
+// against cycles in malformed traces.
+func appendTree(rows []row, n *node, visited map[*node]struct{}) []row {
+	visited[n] = struct{}{}
+	rows = append(rows, row{
+		name: n.span.Name,
+		span: n.span,
+		note: n.orphan,
+	})
+	return appendChildRows(rows, n, "", visited)
+}
+
+func appendChildRows(rows []row, n *node, prefix string, visited map[*node]struct{}) []row {
+	var children []*node
+	for _, child := range n.children {
+		if _, ok := visited[child]; !ok {
+			children = append(children, child)
+		}
+	}
+	for i, child := range children {
+		visited[child] = struct{}{}
+		branch, indent := "├─ ", "│  "
+		if i == len(children)-1 {
+			branch, indent = "└─ ", "   "
+		}
+		rows = append(rows, row{
+			name: prefix + branch + child.span.Name,
+			span: child.span,
+		})
+		rows = appendChildRows(rows, child, prefix+indent, visited)
+	}
+	return rows
+}
+
+// bar returns a bar chart of the provided width for a span that starts offset microseconds after the start of a trace
+// with the provided total duration in microseconds.
+func bar(width int, offset, duration, total int64) string {
+	if total <= 0 {
+		total = 1
+	}
+	begin := int(offset * int64(width) / total)
+	if begin >= width {
+		begin = width - 1
+	}
+	length := int((duration*int64(width) + total/2) / total)
+	if length < 1 {
+		length = 1
+	}
+	if begin+length > width {
+		length = width - begin
+	}
+	return strings.Repeat(" ", begin) + strings.Repeat("█", length) + strings.Repeat(" ", width-begin-length)
+}
+
+func padLeft(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return strings.Repeat(" ", width-n) + s
+	}
+	return s
+}
+
+func padRight(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
+
+func spanStart(span logging.Span) int64 {
+	return int64(span.Timestamp)
+}
+
+func spanEnd(span logging.Span) int64 {
+	return int64(span.Timestamp) + int64(span.Duration)
+}
+
+func microsDuration(micros int64) time.Duration {
+	return time.Duration(micros) * time.Microsecond
+}
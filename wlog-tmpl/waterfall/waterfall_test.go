@@ -0,0 +1,97 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package waterfall_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/palantir/pkg/safelong"
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog-tmpl/waterfall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	c := waterfall.New(waterfall.BarWidth(20))
+	// spans are added in the order in which they finish, so children are added before their parents
+	c.Add(span("trace-1", "c", "grandchild", "b", 3000, 1000))
+	c.Add(span("trace-1", "b", "first child", "a", 1000, 5000))
+	c.Add(span("trace-1", "d", "second child", "a", 7000, 2000))
+	c.Add(span("trace-2", "x", "other trace", "", 0, 10))
+	c.Add(span("trace-1", "a", "root", "", 0, 10000))
+	c.Add(span("trace-1", "e", "orphan", "missing", 9000, 1000))
+
+	assert.Equal(t, []string{"trace-1", "trace-2"}, c.TraceIDs())
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.RenderAll(buf))
+	assert.Equal(t, strings.Join([]string{
+		"trace trace-1 (5 spans, 10ms)",
+		"  root                   +0s      10ms  |████████████████████|",
+		"  ├─ first child        +1ms       5ms  |  ██████████        |",
+		"  │  └─ grandchild      +3ms       1ms  |      ██            |",
+		"  └─ second child       +7ms       2ms  |              ████  |",
+		"  orphan                +9ms       1ms  |                  ██|  (orphaned: parent missing not found)",
+		"",
+		"trace trace-2 (1 span, 10µs)",
+		"  other trace       +0s      10µs  |████████████████████|",
+		"",
+	}, "\n"), buf.String())
+}
+
+func TestRenderOptions(t *testing.T) {
+	c := waterfall.New(waterfall.BarWidth(0), waterfall.MaxNameWidth(8))
+	c.Add(span("trace-1", "a", "a very long name", "", 0, 1000))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Render(buf, "trace-1"))
+	assert.Equal(t, "trace trace-1 (1 span, 1ms)\n  a very …       +0s       1ms\n", buf.String())
+
+	c.Remove("trace-1")
+	assert.Empty(t, c.TraceIDs())
+	assert.EqualError(t, c.Render(buf, "trace-1"), "no spans for trace trace-1")
+}
+
+func TestRenderParentCycle(t *testing.T) {
+	c := waterfall.New(waterfall.BarWidth(0))
+	c.Add(span("trace-1", "a", "first", "b", 0, 1000))
+	c.Add(span("trace-1", "b", "second", "a", 500, 1000))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, c.Render(buf, "trace-1"))
+	assert.Equal(t, strings.Join([]string{
+		"trace trace-1 (2 spans, 1.5ms)",
+		"  first           +0s       1ms  (orphaned: parent b is part of a cycle)",
+		"  └─ second    +500µs       1ms",
+		"",
+	}, "\n"), buf.String())
+}
+
+func span(traceID, id, name, parentID string, timestamp, duration int64) logging.Span {
+	s := logging.Span{
+		TraceId:   traceID,
+		Id:        id,
+		Name:      name,
+		Timestamp: safelong.SafeLong(1500000000000000 + timestamp),
+		Duration:  safelong.SafeLong(duration),
+	}
+	if parentID != "" {
+		s.ParentId = &parentID
+	}
+	return s
+}
@@ -116,6 +116,10 @@ type zapLogger struct {
 	*wlog.AtomicLogLevel
 }
 
+func (l *zapLogger) Flush() error {
+	return l.logger.Sync()
+}
+
 func (l *zapLogger) Log(params ...wlog.Param) {
 	logOutput(l.logger.Info, "", params)
 }
@@ -49,6 +49,16 @@ func (lp *loggerProvider) NewLeveledLogger(w io.Writer, level wlog.LogLevel) wlo
 	}
 }
 
+// Flush does nothing: each logger has its own zap core, which is synced when the logger is flushed.
+func (lp *loggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources and the zap cores are owned by the loggers.
+func (lp *loggerProvider) Close() error {
+	return nil
+}
+
 func rfc3339NanoTimeEncoder(t time.Time, enc zapcore.PrimitiveArrayEncoder) {
 	enc.AppendString(t.In(time.UTC).Format(time.RFC3339Nano))
 }
@@ -57,5 +67,15 @@ func newZapLogger(w io.Writer, encoderConfig zapcore.EncoderConfig) *zap.Logger
 	// *zapLogger performs its own enforcement in the level-specific methods; no need for zap to check again.
 	level := zap.LevelEnablerFunc(func(zapcore.Level) bool { return true })
 
-	return zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), zapcore.AddSync(w), level))
+	return zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), flushingWriteSyncer{Writer: w}, level))
+}
+
+// flushingWriteSyncer is a zapcore.WriteSyncer that flushes the underlying writer using wlog.FlushWriter when zap syncs
+// it, which avoids syncing files such as os.Stdout that are not buffered by the process.
+type flushingWriteSyncer struct {
+	io.Writer
+}
+
+func (w flushingWriteSyncer) Sync() error {
+	return wlog.FlushWriter(w.Writer)
 }
@@ -15,6 +15,8 @@
 package wlogzap_test
 
 import (
+	"bufio"
+	"bytes"
 	"io"
 	"testing"
 
@@ -22,12 +24,20 @@ import (
 	zapimpl "github.com/palantir/witchcraft-go-logging/wlog-zap/internal"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log/audit2logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log/audit3logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log/beacon1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log/diag1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log/evt1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log/evt2logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log/metric1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log/req1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log/req2logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
@@ -36,6 +46,8 @@ import (
 	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log/trc1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/wrappedlog/wrapped1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/wrappedlog/wrapped1log/wrapped1logtests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSvc1Log(t *testing.T) {
@@ -49,6 +61,18 @@ func TestSvc1Log(t *testing.T) {
 	})
 }
 
+func TestReq1Log(t *testing.T) {
+	req1logtests.JSONTestSuite(t, func(w io.Writer, params ...req1log.LoggerCreatorParam) req1log.Logger {
+		allParams := append([]req1log.LoggerCreatorParam{
+			req1log.Creator(zapimpl.LoggerProvider().NewLogger),
+		}, params...)
+		return req1log.New(
+			w,
+			allParams...,
+		)
+	})
+}
+
 func TestReq2Log(t *testing.T) {
 	req2logtests.JSONTestSuite(t, func(w io.Writer, params ...req2log.LoggerCreatorParam) req2log.Logger {
 		allParams := append([]req2log.LoggerCreatorParam{
@@ -61,6 +85,15 @@ func TestReq2Log(t *testing.T) {
 	})
 }
 
+func TestEvt1Log(t *testing.T) {
+	evt1logtests.JSONTestSuite(t, func(w io.Writer) evt1log.Logger {
+		return evt1log.NewFromCreator(
+			w,
+			zapimpl.LoggerProvider().NewLogger,
+		)
+	})
+}
+
 func TestEvt2Log(t *testing.T) {
 	evt2logtests.JSONTestSuite(t, func(w io.Writer) evt2log.Logger {
 		return evt2log.NewFromCreator(
@@ -97,6 +130,24 @@ func TestAudit2Log(t *testing.T) {
 	})
 }
 
+func TestAudit3Log(t *testing.T) {
+	audit3logtests.JSONTestSuite(t, func(w io.Writer) audit3log.Logger {
+		return audit3log.NewFromCreator(
+			w,
+			zapimpl.LoggerProvider().NewLogger,
+		)
+	})
+}
+
+func TestBeacon1Log(t *testing.T) {
+	beacon1logtests.JSONTestSuite(t, func(w io.Writer) beacon1log.Logger {
+		return beacon1log.NewFromCreator(
+			w,
+			zapimpl.LoggerProvider().NewLogger,
+		)
+	})
+}
+
 func TestDiag1Log(t *testing.T) {
 	diag1logtests.JSONTestSuite(t, func(w io.Writer) diag1log.Logger {
 		return diag1log.NewFromCreator(
@@ -118,6 +169,18 @@ func TestWrapped1LogAudit2Log(t *testing.T) {
 		})
 }
 
+func TestWrapped1LogAudit3Log(t *testing.T) {
+	entityName := "entity"
+	entityVersion := "version"
+	wrapped1logtests.Audit3LogJSONTestSuite(
+		t,
+		entityName,
+		entityVersion,
+		func(w io.Writer) audit3log.Logger {
+			return wrapped1log.NewFromProvider(w, wlog.InfoLevel, zapimpl.LoggerProvider(), entityName, entityVersion).AuditV3()
+		})
+}
+
 func TestWrapped1LogDiag1Log(t *testing.T) {
 	entityName := "entity"
 	entityVersion := "version"
@@ -142,6 +205,17 @@ func TestWrapped1Metric1Log(t *testing.T) {
 	})
 }
 
+func TestWrapped1LogReq1Log(t *testing.T) {
+	entityName := "entity"
+	entityVersion := "version"
+	wrapped1logtests.Req1LogJSONTestSuite(t, entityName, entityVersion, func(w io.Writer, params ...req1log.LoggerCreatorParam) req1log.Logger {
+		allParams := append([]req1log.LoggerCreatorParam{
+			req1log.Creator(zapimpl.LoggerProvider().NewLogger),
+		}, params...)
+		return wrapped1log.NewFromProvider(w, wlog.InfoLevel, zapimpl.LoggerProvider(), entityName, entityVersion).RequestV1(allParams...)
+	})
+}
+
 func TestWrapped1LogReq2Log(t *testing.T) {
 	entityName := "entity"
 	entityVersion := "version"
@@ -176,3 +250,19 @@ func TestWrapped1LogTrc1Log(t *testing.T) {
 			return wrapped1log.NewFromProvider(w, wlog.InfoLevel, zapimpl.LoggerProvider(), entityName, entityVersion).Trace()
 		})
 }
+
+func TestFlush(t *testing.T) {
+	provider := zapimpl.LoggerProvider()
+	buf := &bytes.Buffer{}
+	bufWriter := bufio.NewWriter(buf)
+	logger := svc1log.NewFromCreator(bufWriter, wlog.InfoLevel, provider.NewLeveledLogger)
+
+	logger.Info("hello")
+	assert.Empty(t, buf.String())
+	require.NoError(t, wlog.Flush(logger))
+	assert.Contains(t, buf.String(), `"message":"hello"`)
+
+	logger.Info("goodbye")
+	require.NoError(t, wlog.Close(logger))
+	assert.Contains(t, buf.String(), `"message":"goodbye"`)
+}
@@ -15,6 +15,7 @@
 package zeroimpl
 
 import (
+	"io"
 	"reflect"
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
@@ -157,10 +158,15 @@ func (e *zeroLogEntry) Evt() *zerolog.Event {
 }
 
 type zeroLogger struct {
+	w      io.Writer
 	logger zerolog.Logger
 	*wlog.AtomicLogLevel
 }
 
+func (l *zeroLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
 func (l *zeroLogger) Log(params ...wlog.Param) {
 	logOutput(l.logger.Log, "", params)
 }
@@ -29,13 +29,25 @@ type loggerProvider struct{}
 
 func (lp *loggerProvider) NewLogger(w io.Writer) wlog.Logger {
 	return &zeroLogger{
+		w:      w,
 		logger: zerolog.New(w),
 	}
 }
 
 func (lp *loggerProvider) NewLeveledLogger(w io.Writer, level wlog.LogLevel) wlog.LeveledLogger {
 	return &zeroLogger{
+		w:              w,
 		logger:         zerolog.New(w),
 		AtomicLogLevel: wlog.NewAtomicLogLevel(level),
 	}
 }
+
+// Flush does nothing: zerolog writes every entry to the writer of its logger, which is flushed by the logger.
+func (lp *loggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources.
+func (lp *loggerProvider) Close() error {
+	return nil
+}
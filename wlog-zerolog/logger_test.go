@@ -22,12 +22,20 @@ import (
 	wlogzerolog "github.com/palantir/witchcraft-go-logging/wlog-zerolog"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log/audit2logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log/audit3logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log/beacon1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log/diag1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log/evt1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log/evt2logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log/metric1logtests"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log/req1logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log/req2logtests"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
@@ -49,6 +57,18 @@ func TestSvc1Log(t *testing.T) {
 	})
 }
 
+func TestReq1Log(t *testing.T) {
+	req1logtests.JSONTestSuite(t, func(w io.Writer, params ...req1log.LoggerCreatorParam) req1log.Logger {
+		allParams := append([]req1log.LoggerCreatorParam{
+			req1log.Creator(wlogzerolog.LoggerProvider().NewLogger),
+		}, params...)
+		return req1log.New(
+			w,
+			allParams...,
+		)
+	})
+}
+
 func TestReq2Log(t *testing.T) {
 	req2logtests.JSONTestSuite(t, func(w io.Writer, params ...req2log.LoggerCreatorParam) req2log.Logger {
 		allParams := append([]req2log.LoggerCreatorParam{
@@ -61,6 +81,15 @@ func TestReq2Log(t *testing.T) {
 	})
 }
 
+func TestEvt1Log(t *testing.T) {
+	evt1logtests.JSONTestSuite(t, func(w io.Writer) evt1log.Logger {
+		return evt1log.NewFromCreator(
+			w,
+			wlogzerolog.LoggerProvider().NewLogger,
+		)
+	})
+}
+
 func TestEvt2Log(t *testing.T) {
 	evt2logtests.JSONTestSuite(t, func(w io.Writer) evt2log.Logger {
 		return evt2log.NewFromCreator(
@@ -97,6 +126,24 @@ func TestAudit2Log(t *testing.T) {
 	})
 }
 
+func TestAudit3Log(t *testing.T) {
+	audit3logtests.JSONTestSuite(t, func(w io.Writer) audit3log.Logger {
+		return audit3log.NewFromCreator(
+			w,
+			wlogzerolog.LoggerProvider().NewLogger,
+		)
+	})
+}
+
+func TestBeacon1Log(t *testing.T) {
+	beacon1logtests.JSONTestSuite(t, func(w io.Writer) beacon1log.Logger {
+		return beacon1log.NewFromCreator(
+			w,
+			wlogzerolog.LoggerProvider().NewLogger,
+		)
+	})
+}
+
 func TestDiag1Log(t *testing.T) {
 	diag1logtests.JSONTestSuite(t, func(w io.Writer) diag1log.Logger {
 		return diag1log.NewFromCreator(
@@ -118,6 +165,18 @@ func TestWrapped1Audit2Log(t *testing.T) {
 		})
 }
 
+func TestWrapped1Audit3Log(t *testing.T) {
+	entityName := "entity"
+	entityVersion := "version"
+	wrapped1logtests.Audit3LogJSONTestSuite(
+		t,
+		entityName,
+		entityVersion,
+		func(w io.Writer) audit3log.Logger {
+			return wrapped1log.NewFromProvider(w, wlog.InfoLevel, wlogzerolog.LoggerProvider(), entityName, entityVersion).AuditV3()
+		})
+}
+
 func TestWrapped1Diag1Log(t *testing.T) {
 	entityName := "entity"
 	entityVersion := "version"
@@ -142,6 +201,17 @@ func TestWrapped1Metric1Log(t *testing.T) {
 	})
 }
 
+func TestWrapped1Req1Log(t *testing.T) {
+	entityName := "entity"
+	entityVersion := "version"
+	wrapped1logtests.Req1LogJSONTestSuite(t, entityName, entityVersion, func(w io.Writer, params ...req1log.LoggerCreatorParam) req1log.Logger {
+		allParams := append([]req1log.LoggerCreatorParam{
+			req1log.Creator(wlogzerolog.LoggerProvider().NewLogger),
+		}, params...)
+		return wrapped1log.NewFromProvider(w, wlog.InfoLevel, wlogzerolog.LoggerProvider(), entityName, entityVersion).RequestV1(allParams...)
+	})
+}
+
 func TestWrapped1Req2Log(t *testing.T) {
 	entityName := "entity"
 	entityVersion := "version"
@@ -51,3 +51,11 @@ func (l *warnLogger) Audit(name string, result AuditResultType, params ...Param)
 	NewFromCreator(buf, l.creator).Audit(name, result, params...)
 	_, _ = fmt.Fprintln(l.w, wloginternal.WarnLoggerOutput("audit2log", buf.String(), 2))
 }
+
+func (l *warnLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
+func (l *warnLogger) Close() error {
+	return l.Flush()
+}
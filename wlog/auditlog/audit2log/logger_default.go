@@ -28,6 +28,14 @@ func (l *defaultLogger) Audit(name string, result AuditResultType, params ...Par
 	l.logger.Log(ToParams(name, result, params)...)
 }
 
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
 func ToParams(name string, result AuditResultType, inParams []Param) []wlog.Param {
 	outParams := make([]wlog.Param, len(defaultTypeParam)+1+len(inParams))
 	copy(outParams, defaultTypeParam)
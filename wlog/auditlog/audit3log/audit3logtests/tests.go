@@ -0,0 +1,291 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit3logtests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const uuidRegExp = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
+
+type TestCase struct {
+	Name        string
+	AuditName   string
+	AuditResult audit3log.AuditResultType
+	Params      []audit3log.Param
+	JSONMatcher objmatcher.MapMatcher
+}
+
+func TestCases() []TestCase {
+	return []TestCase{
+		{
+			Name:        "basic audit log entry",
+			AuditName:   "AUDITED_ACTION_NAME",
+			AuditResult: audit3log.AuditResultSuccess,
+			Params: []audit3log.Param{
+				audit3log.UID("user-1"),
+				audit3log.SID("session-1"),
+				audit3log.TokenID("X-Y-Z"),
+				audit3log.OrgID("org-1"),
+				audit3log.TraceID("trace-id-1"),
+				audit3log.Deployment("deployment-1"),
+				audit3log.Host("host-1"),
+				audit3log.Product("product-1"),
+				audit3log.ProductVersion("1.2.3"),
+				audit3log.Stack("stack-1"),
+				audit3log.Service("service-1"),
+				audit3log.Environment("production"),
+				audit3log.ProducerType(audit3log.AuditProducerClient),
+				audit3log.EventID("c7a5ab3f-5b2e-4a36-8c44-1a3b1f2d6e10"),
+				audit3log.UserAgent("curl/7.0"),
+				audit3log.RequestID("request-1"),
+				audit3log.Sequence(3),
+				audit3log.Categories("DATA_LOAD", "USER_LOGIN"),
+				audit3log.Entities(map[string]interface{}{"type": "dataset", "rid": "ri.dataset.1"}),
+				audit3log.Users(
+					audit3log.User{
+						UID:      "user-1",
+						UserName: "jdoe",
+						Roles:    []string{"owner"},
+						Realm:    "palantir",
+					},
+					audit3log.User{
+						UID:    "user-2",
+						Groups: []string{"group-1"},
+					},
+				),
+				audit3log.Organizations(audit3log.Organization{ID: "org-1", Reason: "owner"}),
+				audit3log.Origins("10.0.0.1", "10.0.0.2"),
+				audit3log.SourceOrigin("10.0.0.1"),
+				audit3log.Origin("0.0.0.0"),
+				audit3log.RequestParams(map[string]interface{}{"requestKey": "requestValue"}),
+				audit3log.ResultParams(map[string]interface{}{"resultKey": "resultValue"}),
+			},
+			JSONMatcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"type":           objmatcher.NewEqualsMatcher("audit.3"),
+				"time":           objmatcher.NewRegExpMatcher(".+"),
+				"uid":            objmatcher.NewEqualsMatcher("user-1"),
+				"sid":            objmatcher.NewEqualsMatcher("session-1"),
+				"tokenId":        objmatcher.NewEqualsMatcher("X-Y-Z"),
+				"orgId":          objmatcher.NewEqualsMatcher("org-1"),
+				"traceId":        objmatcher.NewEqualsMatcher("trace-id-1"),
+				"deployment":     objmatcher.NewEqualsMatcher("deployment-1"),
+				"host":           objmatcher.NewEqualsMatcher("host-1"),
+				"product":        objmatcher.NewEqualsMatcher("product-1"),
+				"productVersion": objmatcher.NewEqualsMatcher("1.2.3"),
+				"stack":          objmatcher.NewEqualsMatcher("stack-1"),
+				"service":        objmatcher.NewEqualsMatcher("service-1"),
+				"environment":    objmatcher.NewEqualsMatcher("production"),
+				"producerType":   objmatcher.NewEqualsMatcher("CLIENT"),
+				"eventId":        objmatcher.NewEqualsMatcher("c7a5ab3f-5b2e-4a36-8c44-1a3b1f2d6e10"),
+				"userAgent":      objmatcher.NewEqualsMatcher("curl/7.0"),
+				"requestId":      objmatcher.NewEqualsMatcher("request-1"),
+				"sequence":       objmatcher.NewEqualsMatcher(json.Number("3")),
+				"categories":     objmatcher.NewEqualsMatcher([]interface{}{"DATA_LOAD", "USER_LOGIN"}),
+				"entities": objmatcher.NewEqualsMatcher([]interface{}{
+					map[string]interface{}{"type": "dataset", "rid": "ri.dataset.1"},
+				}),
+				"users": objmatcher.NewEqualsMatcher([]interface{}{
+					map[string]interface{}{
+						"uid":      "user-1",
+						"userName": "jdoe",
+						"groups":   []interface{}{},
+						"roles":    []interface{}{"owner"},
+						"realm":    "palantir",
+					},
+					map[string]interface{}{
+						"uid":    "user-2",
+						"groups": []interface{}{"group-1"},
+						"roles":  []interface{}{},
+					},
+				}),
+				"organizations": objmatcher.NewEqualsMatcher([]interface{}{
+					map[string]interface{}{"id": "org-1", "reason": "owner"},
+				}),
+				"origins":      objmatcher.NewEqualsMatcher([]interface{}{"10.0.0.1", "10.0.0.2"}),
+				"sourceOrigin": objmatcher.NewEqualsMatcher("10.0.0.1"),
+				"origin":       objmatcher.NewEqualsMatcher("0.0.0.0"),
+				"name":         objmatcher.NewEqualsMatcher("AUDITED_ACTION_NAME"),
+				"result":       objmatcher.NewEqualsMatcher("SUCCESS"),
+				"requestParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"requestKey": objmatcher.NewEqualsMatcher("requestValue"),
+				}),
+				"resultParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"resultKey": objmatcher.NewEqualsMatcher("resultValue"),
+				}),
+			}),
+		},
+		{
+			Name:        "audit log entry with default event ID and producer type",
+			AuditName:   "AUDITED_ACTION_NAME",
+			AuditResult: audit3log.AuditResultUnauthorized,
+			JSONMatcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"type":         objmatcher.NewEqualsMatcher("audit.3"),
+				"time":         objmatcher.NewRegExpMatcher(".+"),
+				"producerType": objmatcher.NewEqualsMatcher("SERVER"),
+				"eventId":      objmatcher.NewRegExpMatcher(uuidRegExp),
+				"name":         objmatcher.NewEqualsMatcher("AUDITED_ACTION_NAME"),
+				"result":       objmatcher.NewEqualsMatcher("UNAUTHORIZED"),
+			}),
+		},
+	}
+}
+
+func JSONTestSuite(t *testing.T, loggerProvider func(w io.Writer) audit3log.Logger) {
+	jsonOutputTests(t, loggerProvider)
+	rParamIsntOverwrittenByRParamsTest(t, loggerProvider)
+	extraRParamsDoNotAppear(t, loggerProvider)
+}
+
+func jsonOutputTests(t *testing.T, loggerProvider func(w io.Writer) audit3log.Logger) {
+	for i, tc := range TestCases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := loggerProvider(buf)
+
+			logger.Audit(tc.AuditName, tc.AuditResult, tc.Params...)
+
+			gotAuditLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := safejson.Unmarshal(logEntry, &gotAuditLog)
+			require.NoError(t, err, "Case %d: %s\nAudit log line is not a valid map: %v", i, tc.Name, string(logEntry))
+
+			assert.NoError(t, tc.JSONMatcher.Matches(gotAuditLog), "Case %d: %s", i, tc.Name)
+		})
+	}
+}
+
+// Verifies that if different parameters are specified using ResultParam/RequestParam and ResultParams/RequestParams,
+// all of the values are present in the final output (that is, these parameters should be additive).
+func rParamIsntOverwrittenByRParamsTest(t *testing.T, loggerProvider func(w io.Writer) audit3log.Logger) {
+	mapFieldMatcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"key1": objmatcher.NewEqualsMatcher("val1"),
+		"key2": objmatcher.NewEqualsMatcher("val2"),
+	})
+	for i, tc := range []struct {
+		name     string
+		params   []audit3log.Param
+		paramKey string
+	}{
+		{
+			name: "ResultParam params are additive",
+			params: []audit3log.Param{
+				audit3log.ResultParam("key1", "val1"),
+				audit3log.ResultParams(map[string]interface{}{"key2": "val2"}),
+			},
+			paramKey: "resultParams",
+		},
+		{
+			name: "RequestParam params are additive",
+			params: []audit3log.Param{
+				audit3log.RequestParam("key1", "val1"),
+				audit3log.RequestParams(map[string]interface{}{"key2": "val2"}),
+			},
+			paramKey: "requestParams",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := loggerProvider(&buf)
+
+			logger.Audit("audited action name", audit3log.AuditResultSuccess, tc.params...)
+
+			auditLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := safejson.Unmarshal(logEntry, &auditLog)
+			require.NoError(t, err, "Case %d: %s\nAudit log line is not a valid map: %v", i, tc.name, string(logEntry))
+
+			want := baseMatcher()
+			want[tc.paramKey] = mapFieldMatcher
+			assert.NoError(t, want.Matches(auditLog), "Case %d: %s", i, tc.name)
+		})
+	}
+}
+
+// Verifies that parameters remain separate between different logger calls (ensures there is not a bug where parameters
+// are modified by making a logger call).
+func extraRParamsDoNotAppear(t *testing.T, loggerProvider func(w io.Writer) audit3log.Logger) {
+	for i, tc := range []struct {
+		name       string
+		paramKey   string
+		paramFunc  func(key string, val interface{}) audit3log.Param
+		paramsFunc func(map[string]interface{}) audit3log.Param
+	}{
+		{
+			name:       "Params stay separate across calls for ResultParam",
+			paramKey:   "resultParams",
+			paramFunc:  audit3log.ResultParam,
+			paramsFunc: audit3log.ResultParams,
+		},
+		{
+			name:       "Params stay separate across calls for RequestParam",
+			paramKey:   "requestParams",
+			paramFunc:  audit3log.RequestParam,
+			paramsFunc: audit3log.RequestParams,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := loggerProvider(&buf)
+
+			reusedParams := tc.paramsFunc(map[string]interface{}{"key1": "val1"})
+
+			logger.Audit("audited action name", audit3log.AuditResultSuccess, reusedParams, tc.paramFunc("key2", "val2"))
+			want := baseMatcher()
+			want[tc.paramKey] = objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key1": objmatcher.NewEqualsMatcher("val1"),
+				"key2": objmatcher.NewEqualsMatcher("val2"),
+			})
+			auditLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := json.Unmarshal(logEntry, &auditLog)
+			require.NoError(t, err, "Case %d: %s\nAudit log is not a valid map: %v", i, tc.name, string(logEntry))
+			assert.NoError(t, want.Matches(auditLog), "Case %d: %s", i, tc.name)
+
+			buf.Reset()
+			logger.Audit("audited action name", audit3log.AuditResultSuccess, reusedParams)
+
+			want = baseMatcher()
+			want[tc.paramKey] = objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key1": objmatcher.NewEqualsMatcher("val1"),
+			})
+			auditLog = map[string]interface{}{}
+			logEntry = buf.Bytes()
+			err = json.Unmarshal(logEntry, &auditLog)
+			require.NoError(t, err, "Case %d: %s\nAudit log is not a valid map: %v", i, tc.name, string(logEntry))
+			assert.NoError(t, want.Matches(auditLog), "Case %d: %s", i, tc.name)
+		})
+	}
+}
+
+func baseMatcher() objmatcher.MapMatcher {
+	return objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"time":         objmatcher.NewRegExpMatcher(".+"),
+		"type":         objmatcher.NewEqualsMatcher("audit.3"),
+		"producerType": objmatcher.NewEqualsMatcher("SERVER"),
+		"eventId":      objmatcher.NewRegExpMatcher(uuidRegExp),
+		"name":         objmatcher.NewEqualsMatcher("audited action name"),
+		"result":       objmatcher.NewEqualsMatcher("SUCCESS"),
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit3log
+
+import (
+	"time"
+
+	"github.com/palantir/pkg/uuid"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+type defaultLogger struct {
+	logger wlog.Logger
+}
+
+func (l *defaultLogger) Audit(name string, result AuditResultType, params ...Param) {
+	l.logger.Log(ToParams(name, result, params)...)
+}
+
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
+func ToParams(name string, result AuditResultType, inParams []Param) []wlog.Param {
+	outParams := make([]wlog.Param, len(defaultTypeParam)+2+len(inParams))
+	copy(outParams, defaultTypeParam)
+	outParams[len(defaultTypeParam)] = wlog.NewParam(auditNameResultParam(name, result).apply)
+	outParams[len(defaultTypeParam)+1] = wlog.NewParam(defaultValuesParam(inParams).apply)
+	for idx := range inParams {
+		outParams[len(defaultTypeParam)+2+idx] = wlog.NewParam(inParams[idx].apply)
+	}
+	return outParams
+}
+
+// defaultValuesParam returns a Param that sets the default event ID and producer type unless they are set by one of
+// the provided parameters. The defaults are omitted rather than overwritten because not all logger implementations
+// allow a value to be overwritten once it has been set.
+func defaultValuesParam(params []Param) Param {
+	hasEventID, hasProducerType := false, false
+	for _, p := range params {
+		switch p.(type) {
+		case eventIDParam:
+			hasEventID = true
+		case producerTypeParam:
+			hasProducerType = true
+		}
+	}
+	return paramFunc(func(entry wlog.LogEntry) {
+		if !hasEventID {
+			entry.StringValue(EventIDKey, uuid.NewUUID().String())
+		}
+		if !hasProducerType {
+			entry.StringValue(ProducerTypeKey, string(AuditProducerServer))
+		}
+	})
+}
+
+var defaultTypeParam = []wlog.Param{
+	wlog.NewParam(func(entry wlog.LogEntry) {
+		entry.StringValue(wlog.TypeKey, TypeValue)
+		entry.StringValue(wlog.TimeKey, time.Now().Format(time.RFC3339Nano))
+	}),
+}
@@ -0,0 +1,329 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit3log
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+const (
+	TypeValue = "audit.3"
+
+	DeploymentKey     = "deployment"
+	HostKey           = "host"
+	ProductKey        = "product"
+	ProductVersionKey = "productVersion"
+	StackKey          = "stack"
+	ServiceKey        = "service"
+	EnvironmentKey    = "environment"
+	ProducerTypeKey   = "producerType"
+	OrganizationsKey  = "organizations"
+	EventIDKey        = "eventId"
+	UserAgentKey      = "userAgent"
+	CategoriesKey     = "categories"
+	EntitiesKey       = "entities"
+	UsersKey          = "users"
+	OriginsKey        = "origins"
+	SourceOriginKey   = "sourceOrigin"
+	RequestIDKey      = "requestId"
+	SequenceKey       = "sequence"
+	OriginKey         = "origin"
+	NameKey           = "name"
+	ResultKey         = "result"
+	RequestParamsKey  = "requestParams"
+	ResultParamsKey   = "resultParams"
+
+	UserUIDKey       = "uid"
+	UserNameKey      = "userName"
+	UserFirstNameKey = "firstName"
+	UserLastNameKey  = "lastName"
+	UserGroupsKey    = "groups"
+	UserRolesKey     = "roles"
+	UserRealmKey     = "realm"
+
+	OrganizationIDKey     = "id"
+	OrganizationReasonKey = "reason"
+)
+
+// User is a user that is involved in an audited event along with the context that is known about them.
+type User struct {
+	UID       string
+	UserName  string
+	FirstName string
+	LastName  string
+	Groups    []string
+	Roles     []string
+	Realm     string
+}
+
+// Organization is an organization that is relevant to an audited event along with the reason that it is relevant.
+type Organization struct {
+	ID     string
+	Reason string
+}
+
+type Param interface {
+	apply(entry wlog.LogEntry)
+}
+
+func ApplyParam(p Param, entry wlog.LogEntry) {
+	if p == nil {
+		return
+	}
+	p.apply(entry)
+}
+
+type paramFunc func(entry wlog.LogEntry)
+
+func (f paramFunc) apply(entry wlog.LogEntry) {
+	f(entry)
+}
+
+func auditNameResultParam(name string, resultType AuditResultType) Param {
+	return paramFunc(func(logger wlog.LogEntry) {
+		logger.StringValue(NameKey, name)
+		logger.StringValue(ResultKey, string(resultType))
+	})
+}
+
+func UID(uid string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.UIDKey, uid)
+	})
+}
+
+func SID(sid string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.SIDKey, sid)
+	})
+}
+
+func TokenID(tokenID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.TokenIDKey, tokenID)
+	})
+}
+
+func OrgID(orgID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.OrgIDKey, orgID)
+	})
+}
+
+func TraceID(traceID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.TraceIDKey, traceID)
+	})
+}
+
+func Deployment(deployment string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(DeploymentKey, deployment)
+	})
+}
+
+func Host(host string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(HostKey, host)
+	})
+}
+
+func Product(product string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(ProductKey, product)
+	})
+}
+
+func ProductVersion(productVersion string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(ProductVersionKey, productVersion)
+	})
+}
+
+func Stack(stack string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(StackKey, stack)
+	})
+}
+
+func Service(service string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(ServiceKey, service)
+	})
+}
+
+func Environment(environment string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(EnvironmentKey, environment)
+	})
+}
+
+// ProducerType sets the type of the producer of the entry. Entries are logged with AuditProducerServer if this
+// parameter is not specified.
+func ProducerType(producerType AuditProducerType) Param {
+	return producerTypeParam(producerType)
+}
+
+type producerTypeParam AuditProducerType
+
+func (p producerTypeParam) apply(entry wlog.LogEntry) {
+	entry.StringValue(ProducerTypeKey, string(p))
+}
+
+// EventID sets the unique identifier of the audited event. Entries are logged with a random UUID if this parameter is
+// not specified.
+func EventID(eventID string) Param {
+	return eventIDParam(eventID)
+}
+
+type eventIDParam string
+
+func (p eventIDParam) apply(entry wlog.LogEntry) {
+	entry.StringValue(EventIDKey, string(p))
+}
+
+func UserAgent(userAgent string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(UserAgentKey, userAgent)
+	})
+}
+
+func RequestID(requestID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(RequestIDKey, requestID)
+	})
+}
+
+// Sequence sets the position of the entry in the sequence of entries for the audited event or request.
+func Sequence(sequence int64) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.SafeLongValue(SequenceKey, sequence)
+	})
+}
+
+func Categories(categories ...string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.StringListValue(CategoriesKey, categories)
+	})
+}
+
+// Entities sets the entities that are involved in the audited event. Each entity is logged as its JSON representation.
+func Entities(entities ...interface{}) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		if len(entities) == 0 {
+			return
+		}
+		entry.ObjectValue(EntitiesKey, entities, nil)
+	})
+}
+
+func Users(users ...User) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		if len(users) == 0 {
+			return
+		}
+		userFields := make([]map[string]interface{}, len(users))
+		for i, user := range users {
+			userFields[i] = user.fields()
+		}
+		entry.ObjectValue(UsersKey, userFields, nil)
+	})
+}
+
+func Organizations(organizations ...Organization) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		if len(organizations) == 0 {
+			return
+		}
+		orgFields := make([]map[string]interface{}, len(organizations))
+		for i, org := range organizations {
+			orgFields[i] = map[string]interface{}{
+				OrganizationIDKey:     org.ID,
+				OrganizationReasonKey: org.Reason,
+			}
+		}
+		entry.ObjectValue(OrganizationsKey, orgFields, nil)
+	})
+}
+
+func Origins(origins ...string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.StringListValue(OriginsKey, origins)
+	})
+}
+
+func SourceOrigin(sourceOrigin string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(SourceOriginKey, sourceOrigin)
+	})
+}
+
+func Origin(origin string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(OriginKey, origin)
+	})
+}
+
+func RequestParam(key string, value interface{}) Param {
+	return RequestParams(map[string]interface{}{
+		key: value,
+	})
+}
+
+func RequestParams(requestParams map[string]interface{}) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.AnyMapValue(RequestParamsKey, requestParams)
+	})
+}
+
+func ResultParam(key string, value interface{}) Param {
+	return ResultParams(map[string]interface{}{
+		key: value,
+	})
+}
+
+func ResultParams(resultParams map[string]interface{}) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.AnyMapValue(ResultParamsKey, resultParams)
+	})
+}
+
+// fields returns the fields of the user that are logged. Empty optional fields are omitted.
+func (u User) fields() map[string]interface{} {
+	fields := map[string]interface{}{
+		UserUIDKey: u.UID,
+	}
+	for k, v := range map[string]string{
+		UserNameKey:      u.UserName,
+		UserFirstNameKey: u.FirstName,
+		UserLastNameKey:  u.LastName,
+		UserRealmKey:     u.Realm,
+	} {
+		if v != "" {
+			fields[k] = v
+		}
+	}
+	fields[UserGroupsKey] = stringList(u.Groups)
+	fields[UserRolesKey] = stringList(u.Roles)
+	return fields
+}
+
+// stringList returns the provided slice, or an empty slice if it is nil, so that it is logged as an empty list.
+func stringList(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beacon1logtests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TestCase struct {
+	Name         string
+	EventType    string
+	AppName      string
+	AppVersion   string
+	Params       map[string]interface{}
+	BrowserID    string
+	UID          string
+	SID          string
+	TraceID      string
+	UnsafeParams map[string]interface{}
+	JSONMatcher  objmatcher.MapMatcher
+}
+
+func (tc TestCase) BeaconParams() []beacon1log.Param {
+	return []beacon1log.Param{
+		beacon1log.SafeParams(tc.Params),
+		beacon1log.BrowserID(tc.BrowserID),
+		beacon1log.UID(tc.UID),
+		beacon1log.SID(tc.SID),
+		beacon1log.TraceID(tc.TraceID),
+		beacon1log.UnsafeParams(tc.UnsafeParams),
+	}
+}
+
+func TestCases() []TestCase {
+	return []TestCase{
+		{
+			Name:       "basic beacon log entry",
+			EventType:  "compass.SearchEvent.v1",
+			AppName:    "compass",
+			AppVersion: "1.2.3",
+			Params: map[string]interface{}{
+				"resultCount": 10,
+			},
+			BrowserID: "browser-1",
+			UID:       "user-1",
+			SID:       "session-1",
+			TraceID:   "trace-1",
+			UnsafeParams: map[string]interface{}{
+				"query": "secret search",
+			},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+				"time":       objmatcher.NewRegExpMatcher(".+"),
+				"eventType":  objmatcher.NewEqualsMatcher("compass.SearchEvent.v1"),
+				"appName":    objmatcher.NewEqualsMatcher("compass"),
+				"appVersion": objmatcher.NewEqualsMatcher("1.2.3"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"resultCount": objmatcher.NewEqualsMatcher(json.Number("10")),
+				}),
+				"browserId": objmatcher.NewEqualsMatcher("browser-1"),
+				"uid":       objmatcher.NewEqualsMatcher("user-1"),
+				"sid":       objmatcher.NewEqualsMatcher("session-1"),
+				"traceId":   objmatcher.NewEqualsMatcher("trace-1"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"query": objmatcher.NewEqualsMatcher("secret search"),
+				}),
+			},
+		},
+		{
+			Name:       "beacon log entry with only required fields",
+			EventType:  "compass.PageView.v1",
+			AppName:    "compass",
+			AppVersion: "1.2.3",
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+				"time":       objmatcher.NewRegExpMatcher(".+"),
+				"eventType":  objmatcher.NewEqualsMatcher("compass.PageView.v1"),
+				"appName":    objmatcher.NewEqualsMatcher("compass"),
+				"appVersion": objmatcher.NewEqualsMatcher("1.2.3"),
+			},
+		},
+	}
+}
+
+func JSONTestSuite(t *testing.T, loggerProvider func(w io.Writer) beacon1log.Logger) {
+	jsonOutputTests(t, loggerProvider)
+	safeParamIsntOverwrittenBySafeParams(t, loggerProvider)
+	extraSafeParamsIndependentAcrossCalls(t, loggerProvider)
+}
+
+func jsonOutputTests(t *testing.T, loggerProvider func(w io.Writer) beacon1log.Logger) {
+	for i, tc := range TestCases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := loggerProvider(buf)
+
+			logger.Beacon(tc.EventType, tc.AppName, tc.AppVersion, tc.BeaconParams()...)
+
+			gotBeaconLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := safejson.Unmarshal(logEntry, &gotBeaconLog)
+			require.NoError(t, err, "Case %d: %s\nBeacon log line is not a valid map: %v", i, tc.Name, string(logEntry))
+
+			assert.NoError(t, tc.JSONMatcher.Matches(gotBeaconLog), "Case %d: %s", i, tc.Name)
+		})
+	}
+}
+
+// Verifies that if different parameters are specified using SafeParam and SafeParams params, all of the values are
+// present in the final output (that is, these parameters should be additive).
+func safeParamIsntOverwrittenBySafeParams(t *testing.T, loggerProvider func(w io.Writer) beacon1log.Logger) {
+	t.Run("SafeParam and SafeParams params are additive", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := loggerProvider(&buf)
+
+		logger.Beacon("beacon", "app", "1.0.0", beacon1log.SafeParam("key", "value"), beacon1log.SafeParams(map[string]interface{}{"keys": "values"}))
+
+		gotBeaconLog := map[string]interface{}{}
+		logEntry := buf.Bytes()
+		err := safejson.Unmarshal(logEntry, &gotBeaconLog)
+		require.NoError(t, err, "Beacon log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+			"time":       objmatcher.NewRegExpMatcher(".+"),
+			"eventType":  objmatcher.NewEqualsMatcher("beacon"),
+			"appName":    objmatcher.NewEqualsMatcher("app"),
+			"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+			"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key":  objmatcher.NewEqualsMatcher("value"),
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotBeaconLog))
+	})
+}
+
+// Verifies that parameters remain separate between different logger calls (ensures there is not a bug where parameters
+// are modified by making a logger call).
+func extraSafeParamsIndependentAcrossCalls(t *testing.T, loggerProvider func(w io.Writer) beacon1log.Logger) {
+	t.Run("SafeParam and SafeParams params stay separate across logger calls", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := loggerProvider(&buf)
+
+		reusedParams := beacon1log.SafeParams(map[string]interface{}{"keys": "values"})
+		logger.Beacon("beacon", "app", "1.0.0", reusedParams, beacon1log.SafeParam("key", "value"))
+		gotBeaconLog := map[string]interface{}{}
+		logEntry := buf.Bytes()
+		err := safejson.Unmarshal(logEntry, &gotBeaconLog)
+		require.NoError(t, err, "Beacon log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+			"time":       objmatcher.NewRegExpMatcher(".+"),
+			"eventType":  objmatcher.NewEqualsMatcher("beacon"),
+			"appName":    objmatcher.NewEqualsMatcher("app"),
+			"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+			"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key":  objmatcher.NewEqualsMatcher("value"),
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotBeaconLog))
+
+		buf.Reset()
+		logger.Beacon("beacon", "app", "1.0.0", reusedParams)
+
+		gotBeaconLog = map[string]interface{}{}
+		logEntry = buf.Bytes()
+		err = safejson.Unmarshal(logEntry, &gotBeaconLog)
+		require.NoError(t, err, "Beacon log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+			"time":       objmatcher.NewRegExpMatcher(".+"),
+			"eventType":  objmatcher.NewEqualsMatcher("beacon"),
+			"appName":    objmatcher.NewEqualsMatcher("app"),
+			"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+			"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotBeaconLog))
+	})
+}
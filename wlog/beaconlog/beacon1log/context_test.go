@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beacon1log_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger(w io.Writer) beacon1log.Logger {
+	return beacon1log.NewFromCreator(w, wlog.NewJSONMarshalLoggerProvider().NewLogger)
+}
+
+func TestFromContext(t *testing.T) {
+	buf, ctx := newBufAndCtxWithLogger()
+
+	logger := beacon1log.FromContext(ctx)
+	logger.Beacon("testop", "app", "1.0.0")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, len(entries))
+
+	matcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"time":       objmatcher.NewRegExpMatcher(".+"),
+		"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+		"eventType":  objmatcher.NewEqualsMatcher("testop"),
+		"appName":    objmatcher.NewEqualsMatcher("app"),
+		"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+	})
+	err = matcher.Matches(map[string]interface{}(entries[0]))
+	assert.NoError(t, err, "%v", err)
+}
+
+// Tests that the logger returned by beacon1log.FromContext has UID and SID parameters set on it if the context
+// has those values set on it using wlog.
+func TestFromContextUsesCommonIDs(t *testing.T) {
+	buf, ctx := newBufAndCtxWithLogger()
+
+	ctx = wlog.ContextWithUID(ctx, "test-UID")
+	ctx = wlog.ContextWithSID(ctx, "test-SID")
+
+	logger := beacon1log.FromContext(ctx)
+	logger.Beacon("testop", "app", "1.0.0")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, len(entries))
+
+	matcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"time":       objmatcher.NewRegExpMatcher(".+"),
+		"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+		"eventType":  objmatcher.NewEqualsMatcher("testop"),
+		"appName":    objmatcher.NewEqualsMatcher("app"),
+		"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+		"uid":        objmatcher.NewEqualsMatcher("test-UID"),
+		"sid":        objmatcher.NewEqualsMatcher("test-SID"),
+	})
+	err = matcher.Matches(map[string]interface{}(entries[0]))
+	assert.NoError(t, err, "%v", err)
+}
+
+// Tests that the logger returned by beacon1log.FromContext has a TraceID set on it if the context has a wtracing TraceID.
+func TestFromContextSetsTraceID(t *testing.T) {
+	buf, ctx := newBufAndCtxWithLogger()
+
+	// create a no-op tracer to use for the test
+	tracer, err := wzipkin.NewTracer(wtracing.NewNoopReporter())
+	require.NoError(t, err)
+
+	createMatcher := func(name, traceID string) objmatcher.Matcher {
+		matcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"time":       objmatcher.NewRegExpMatcher(".+"),
+			"type":       objmatcher.NewEqualsMatcher("beacon.1"),
+			"eventType":  objmatcher.NewEqualsMatcher(name),
+			"appName":    objmatcher.NewEqualsMatcher("app"),
+			"appVersion": objmatcher.NewEqualsMatcher("1.0.0"),
+		})
+		if traceID != "" {
+			matcher["traceId"] = objmatcher.NewEqualsMatcher(traceID)
+		}
+		return matcher
+	}
+
+	// logger output should have no TraceID (none set as parameter and none exists in context)
+	logger := beacon1log.FromContext(ctx)
+	logger.Beacon("testop_0", "app", "1.0.0")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+	matcher := createMatcher("testop_0", "")
+	err = matcher.Matches(map[string]interface{}(entries[0]))
+	assert.NoError(t, err, "%v", err)
+	buf.Reset()
+
+	// logger output should have TraceID set in context (span is set on context)
+	spanOne := tracer.StartSpan("spanOne")
+	ctx = wtracing.ContextWithSpan(ctx, spanOne)
+	logger = beacon1log.FromContext(ctx)
+	logger.Beacon("testop_1", "app", "1.0.0")
+
+	entries, err = logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+	matcher = createMatcher("testop_1", string(spanOne.Context().TraceID))
+	err = matcher.Matches(map[string]interface{}(entries[0]))
+	assert.NoError(t, err, "%v", err)
+	buf.Reset()
+
+	// manually adding a TraceID parameter will override the TraceID (because it is applied after the context one)
+	logger = beacon1log.WithParams(logger, beacon1log.TraceID("manually-set-trace-id"))
+	logger.Beacon("testop_2", "app", "1.0.0")
+
+	entries, err = logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(entries))
+	matcher = createMatcher("testop_2", "manually-set-trace-id")
+	err = matcher.Matches(map[string]interface{}(entries[0]))
+	assert.NoError(t, err, "%v", err)
+	buf.Reset()
+}
+
+func newBufAndCtxWithLogger() (*bytes.Buffer, context.Context) {
+	buf := &bytes.Buffer{}
+	ctx := beacon1log.WithLogger(context.Background(), newTestLogger(buf))
+	return buf, ctx
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package beacon1log
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+const (
+	TypeValue = "beacon.1"
+
+	EventTypeKey  = "eventType"
+	AppNameKey    = "appName"
+	AppVersionKey = "appVersion"
+	ParamsKey     = "params"
+	BrowserIDKey  = "browserId"
+)
+
+type Param interface {
+	apply(entry wlog.LogEntry)
+}
+
+func ApplyParam(p Param, entry wlog.LogEntry) {
+	if p == nil {
+		return
+	}
+	p.apply(entry)
+}
+
+type paramFunc func(entry wlog.LogEntry)
+
+func (f paramFunc) apply(entry wlog.LogEntry) {
+	f(entry)
+}
+
+func beaconParams(eventType, appName, appVersion string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.StringValue(EventTypeKey, eventType)
+		entry.StringValue(AppNameKey, appName)
+		entry.StringValue(AppVersionKey, appVersion)
+	})
+}
+
+func SafeParam(key string, value interface{}) Param {
+	return SafeParams(map[string]interface{}{
+		key: value,
+	})
+}
+
+func SafeParams(safe map[string]interface{}) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.AnyMapValue(ParamsKey, safe)
+	})
+}
+
+func BrowserID(browserID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(BrowserIDKey, browserID)
+	})
+}
+
+func UID(uid string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.UIDKey, uid)
+	})
+}
+
+func SID(sid string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.SIDKey, sid)
+	})
+}
+
+func TraceID(traceID string) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.OptionalStringValue(wlog.TraceIDKey, traceID)
+	})
+}
+
+func UnsafeParam(key string, value interface{}) Param {
+	return UnsafeParams(map[string]interface{}{
+		key: value,
+	})
+}
+
+func UnsafeParams(unsafe map[string]interface{}) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		entry.AnyMapValue(wlog.UnsafeParamsKey, unsafe)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag1log
+
+import (
+	"context"
+	"errors"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+)
+
+// Collector collects a diagnostic on demand, such as a snapshot of the state of the runtime or the process.
+type Collector interface {
+	Collect() (logging.Diagnostic, error)
+}
+
+type CollectorFunc func() (logging.Diagnostic, error)
+
+func (f CollectorFunc) Collect() (logging.Diagnostic, error) {
+	return f()
+}
+
+// DefaultCollectors returns the built-in collectors of memory statistics, GC pauses, build information, open file
+// descriptors and cgroup limits.
+func DefaultCollectors() []Collector {
+	return []Collector{
+		MemStatsCollector(),
+		GCPausesCollector(),
+		BuildInfoCollector(),
+		OpenFileDescriptorsCollector(),
+		CgroupLimitsCollector(),
+	}
+}
+
+// LogDiagnostics collects a diagnostic from each of the provided collectors and logs it with the provided params using
+// the Logger of the provided context. A collector that fails does not prevent the diagnostics of the other collectors
+// from being logged: the errors of all failed collectors are returned joined together.
+func LogDiagnostics(ctx context.Context, collectors []Collector, params ...Param) error {
+	logger := FromContext(ctx)
+	var errs []error
+	for _, collector := range collectors {
+		if collector == nil {
+			continue
+		}
+		diagnostic, err := collector.Collect()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		logger.Diagnostic(diagnostic, params...)
+	}
+	return errors.Join(errs...)
+}
+
+func newGenericDiagnostic(diagnosticType string, value interface{}) logging.Diagnostic {
+	return logging.NewDiagnosticFromGeneric(logging.GenericDiagnostic{
+		DiagnosticType: diagnosticType,
+		Value:          value,
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag1log
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+)
+
+const (
+	OpenFileDescriptorsDiagnosticType = "os.fd.v1"
+	CgroupLimitsDiagnosticType        = "linux.cgroup.limits.v1"
+)
+
+const (
+	procSelfFdDir      = "/proc/self/fd"
+	procSelfCgroupFile = "proc/self/cgroup"
+	cgroupRootDir      = "sys/fs/cgroup"
+
+	// cgroupV1UnlimitedMemory is the smallest memory limit treated as unlimited for cgroup v1, which reports unlimited
+	// memory as the largest page-aligned int64.
+	cgroupV1UnlimitedMemory = 1 << 62
+)
+
+// OpenFileDescriptorsCollector returns a Collector of the number of file descriptors opened by the process, as listed
+// in /proc/self/fd. Collection fails on platforms that do not provide /proc/self/fd.
+func OpenFileDescriptorsCollector() Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		entries, err := os.ReadDir(procSelfFdDir)
+		if err != nil {
+			return logging.Diagnostic{}, fmt.Errorf("failed to list open file descriptors: %v", err)
+		}
+		// the listing includes the file descriptor used to read the directory itself
+		open := len(entries)
+		if open > 0 {
+			open--
+		}
+		return newGenericDiagnostic(OpenFileDescriptorsDiagnosticType, map[string]interface{}{
+			"open": open,
+		}), nil
+	})
+}
+
+// CgroupLimitsCollector returns a Collector of the CPU and memory limits of the cgroup of the process. Both cgroup v1
+// and v2 are supported. The CPU limit is reported as the quota and period of the CFS scheduler and as the number of
+// cores they amount to. Limits that are not set are omitted. Collection fails on platforms without cgroups.
+func CgroupLimitsCollector() Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		limits, err := cgroupLimits(os.DirFS("/"))
+		if err != nil {
+			return logging.Diagnostic{}, err
+		}
+		return newGenericDiagnostic(CgroupLimitsDiagnosticType, limits), nil
+	})
+}
+
+// cgroupLimits returns the CPU and memory limits of the cgroup of the process using the provided file system, which
+// is rooted at "/".
+func cgroupLimits(fsys fs.FS) (map[string]interface{}, error) {
+	content, err := fs.ReadFile(fsys, procSelfCgroupFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cgroup of process: %v", err)
+	}
+
+	// Lines are of the form 'hierarchy-ID:controller-list:cgroup-path'. The cgroup v2 hierarchy has the ID 0 and no
+	// controllers.
+	var v2Path *string
+	v1Paths := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			v2Path = &fields[2]
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			v1Paths[controller] = fields[2]
+		}
+	}
+
+	limits := make(map[string]interface{})
+	_, hasV1CPU := v1Paths["cpu"]
+	_, hasV1Memory := v1Paths["memory"]
+	switch {
+	case hasV1CPU || hasV1Memory:
+		limits["cgroupVersion"] = 1
+		if quota, err := readCgroupFile(fsys, path.Join(cgroupRootDir, "cpu"), v1Paths["cpu"], "cpu.cfs_quota_us"); err == nil && quota != "-1" {
+			period, _ := readCgroupFile(fsys, path.Join(cgroupRootDir, "cpu"), v1Paths["cpu"], "cpu.cfs_period_us")
+			addCPULimits(limits, quota, period)
+		}
+		if limit, err := readCgroupFile(fsys, path.Join(cgroupRootDir, "memory"), v1Paths["memory"], "memory.limit_in_bytes"); err == nil {
+			if limitBytes, err := strconv.ParseInt(limit, 10, 64); err == nil && limitBytes < cgroupV1UnlimitedMemory {
+				limits["memoryLimitBytes"] = limitBytes
+			}
+		}
+	case v2Path != nil:
+		limits["cgroupVersion"] = 2
+		// cpu.max is of the form '$MAX $PERIOD', where $MAX is "max" if the CPU is not limited
+		if cpuMax, err := readCgroupFile(fsys, cgroupRootDir, *v2Path, "cpu.max"); err == nil {
+			if fields := strings.Fields(cpuMax); len(fields) == 2 && fields[0] != "max" {
+				addCPULimits(limits, fields[0], fields[1])
+			}
+		}
+		if limit, err := readCgroupFile(fsys, cgroupRootDir, *v2Path, "memory.max"); err == nil && limit != "max" {
+			if limitBytes, err := strconv.ParseInt(limit, 10, 64); err == nil {
+				limits["memoryLimitBytes"] = limitBytes
+			}
+		}
+	default:
+		return nil, errors.New("process does not belong to a cgroup with CPU or memory controllers")
+	}
+	return limits, nil
+}
+
+// readCgroupFile returns the trimmed content of the named file of the cgroup at cgroupPath in the hierarchy mounted at
+// root. Because the cgroup path of a process in a container is often relative to a cgroup namespace that is mounted as
+// the root of the hierarchy, the file is read from root if it does not exist at cgroupPath.
+func readCgroupFile(fsys fs.FS, root, cgroupPath, name string) (string, error) {
+	content, err := fs.ReadFile(fsys, path.Join(root, cgroupPath, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		content, err = fs.ReadFile(fsys, path.Join(root, name))
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func addCPULimits(limits map[string]interface{}, quota, period string) {
+	quotaMicros, err := strconv.ParseInt(quota, 10, 64)
+	if err != nil {
+		return
+	}
+	limits["cpuQuotaMicros"] = quotaMicros
+	if periodMicros, err := strconv.ParseInt(period, 10, 64); err == nil && periodMicros > 0 {
+		limits["cpuPeriodMicros"] = periodMicros
+		limits["cpuLimitCores"] = float64(quotaMicros) / float64(periodMicros)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag1log
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCgroupLimits(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		files    fstest.MapFS
+		expected map[string]interface{}
+	}{
+		{
+			name: "cgroup v2",
+			files: fstest.MapFS{
+				"proc/self/cgroup": {Data: []byte("0::/system.slice/app.service\n")},
+				"sys/fs/cgroup/system.slice/app.service/cpu.max":    {Data: []byte("150000 100000\n")},
+				"sys/fs/cgroup/system.slice/app.service/memory.max": {Data: []byte("1073741824\n")},
+			},
+			expected: map[string]interface{}{
+				"cgroupVersion":    2,
+				"cpuQuotaMicros":   int64(150000),
+				"cpuPeriodMicros":  int64(100000),
+				"cpuLimitCores":    1.5,
+				"memoryLimitBytes": int64(1073741824),
+			},
+		},
+		{
+			name: "cgroup v2 unlimited in namespace",
+			files: fstest.MapFS{
+				"proc/self/cgroup":         {Data: []byte("0::/kubepods/pod1234\n")},
+				"sys/fs/cgroup/cpu.max":    {Data: []byte("max 100000\n")},
+				"sys/fs/cgroup/memory.max": {Data: []byte("max\n")},
+			},
+			expected: map[string]interface{}{
+				"cgroupVersion": 2,
+			},
+		},
+		{
+			name: "cgroup v1",
+			files: fstest.MapFS{
+				"proc/self/cgroup":                                      {Data: []byte("12:memory:/docker/abc\n4:cpu,cpuacct:/docker/abc\n1:name=systemd:/docker/abc\n0::/docker/abc\n")},
+				"sys/fs/cgroup/cpu/cpu.cfs_quota_us":                    {Data: []byte("200000\n")},
+				"sys/fs/cgroup/cpu/cpu.cfs_period_us":                   {Data: []byte("100000\n")},
+				"sys/fs/cgroup/memory/memory.limit_in_bytes":            {Data: []byte("9223372036854771712\n")},
+				"sys/fs/cgroup/memory/docker/abc/memory.usage_in_bytes": {Data: []byte("1024\n")},
+			},
+			expected: map[string]interface{}{
+				"cgroupVersion":   1,
+				"cpuQuotaMicros":  int64(200000),
+				"cpuPeriodMicros": int64(100000),
+				"cpuLimitCores":   2.0,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			limits, err := cgroupLimits(tc.files)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, limits)
+		})
+	}
+}
+
+func TestCgroupLimitsNoCgroup(t *testing.T) {
+	_, err := cgroupLimits(fstest.MapFS{})
+	assert.Error(t, err)
+
+	_, err = cgroupLimits(fstest.MapFS{"proc/self/cgroup": {Data: []byte("1:name=systemd:/\n")}})
+	assert.EqualError(t, err, "process does not belong to a cgroup with CPU or memory controllers")
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag1log
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	wloginternal "github.com/palantir/witchcraft-go-logging/wlog/internal"
+)
+
+const (
+	MemStatsDiagnosticType       = "go.memstats.v1"
+	RuntimeMetricsDiagnosticType = "go.runtime.metrics.v1"
+	GCPausesDiagnosticType       = "go.gc.pauses.v1"
+	BuildInfoDiagnosticType      = "go.buildinfo.v1"
+)
+
+// MemStatsCollector returns a Collector of the memory allocator statistics reported by runtime.ReadMemStats. Note that
+// runtime.ReadMemStats stops the world while it runs.
+func MemStatsCollector() Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		var stats runtime.MemStats
+		runtime.ReadMemStats(&stats)
+		return newGenericDiagnostic(MemStatsDiagnosticType, map[string]interface{}{
+			"alloc":         stats.Alloc,
+			"totalAlloc":    stats.TotalAlloc,
+			"sys":           stats.Sys,
+			"mallocs":       stats.Mallocs,
+			"frees":         stats.Frees,
+			"heapAlloc":     stats.HeapAlloc,
+			"heapSys":       stats.HeapSys,
+			"heapIdle":      stats.HeapIdle,
+			"heapInuse":     stats.HeapInuse,
+			"heapReleased":  stats.HeapReleased,
+			"heapObjects":   stats.HeapObjects,
+			"stackInuse":    stats.StackInuse,
+			"stackSys":      stats.StackSys,
+			"nextGc":        stats.NextGC,
+			"numGc":         stats.NumGC,
+			"numForcedGc":   stats.NumForcedGC,
+			"pauseTotalNs":  stats.PauseTotalNs,
+			"gcCpuFraction": stats.GCCPUFraction,
+			"numGoroutine":  runtime.NumGoroutine(),
+		}), nil
+	})
+}
+
+// RuntimeMetricsCollector returns a Collector of the runtime/metrics metrics with the provided names. If no names are
+// provided, all metrics supported by the runtime are collected. Histograms are summarized by their total count and
+// their approximate median, 90th and 99th percentile and maximum. Metrics that are not supported by the runtime are
+// omitted.
+func RuntimeMetricsCollector(names ...string) Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		var samples []metrics.Sample
+		if len(names) == 0 {
+			for _, desc := range metrics.All() {
+				samples = append(samples, metrics.Sample{Name: desc.Name})
+			}
+		} else {
+			for _, name := range names {
+				samples = append(samples, metrics.Sample{Name: name})
+			}
+		}
+		metrics.Read(samples)
+
+		values := make(map[string]interface{}, len(samples))
+		for _, sample := range samples {
+			switch sample.Value.Kind() {
+			case metrics.KindUint64:
+				values[sample.Name] = sample.Value.Uint64()
+			case metrics.KindFloat64:
+				if v := sample.Value.Float64(); !math.IsNaN(v) && !math.IsInf(v, 0) {
+					values[sample.Name] = v
+				}
+			case metrics.KindFloat64Histogram:
+				values[sample.Name] = histogramSummary(sample.Value.Float64Histogram())
+			}
+		}
+		return newGenericDiagnostic(RuntimeMetricsDiagnosticType, values), nil
+	})
+}
+
+// histogramSummary returns the total count and the approximate median, 90th and 99th percentile and maximum of h.
+func histogramSummary(h *metrics.Float64Histogram) map[string]interface{} {
+	var count uint64
+	for _, c := range h.Counts {
+		count += c
+	}
+	summary := map[string]interface{}{"count": count}
+	for _, quantile := range []struct {
+		key   string
+		value float64
+	}{
+		{key: "p50", value: 0.5},
+		{key: "p90", value: 0.9},
+		{key: "p99", value: 0.99},
+		{key: "max", value: 1},
+	} {
+		if v, ok := wloginternal.HistogramQuantile(h.Counts, h.Buckets, quantile.value); ok {
+			summary[quantile.key] = v
+		}
+	}
+	return summary
+}
+
+// GCPausesCollector returns a Collector of the garbage collection pause history reported by debug.ReadGCStats. The
+// history includes the duration and end time of up to 256 of the most recent pauses, most recent first, and the
+// minimum, quartiles and maximum of the pause durations.
+func GCPausesCollector() Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		stats := debug.GCStats{PauseQuantiles: make([]time.Duration, 5)}
+		debug.ReadGCStats(&stats)
+
+		pausesNs := make([]int64, len(stats.Pause))
+		for i, pause := range stats.Pause {
+			pausesNs[i] = pause.Nanoseconds()
+		}
+		pauseEnds := make([]string, len(stats.PauseEnd))
+		for i, pauseEnd := range stats.PauseEnd {
+			pauseEnds[i] = pauseEnd.Format(time.RFC3339Nano)
+		}
+		value := map[string]interface{}{
+			"numGc":        stats.NumGC,
+			"pauseTotalNs": stats.PauseTotal.Nanoseconds(),
+			"pausesNs":     pausesNs,
+			"pauseEnds":    pauseEnds,
+		}
+		if stats.NumGC > 0 {
+			value["lastGc"] = stats.LastGC.Format(time.RFC3339Nano)
+			value["pauseQuantilesNs"] = map[string]interface{}{
+				"min": stats.PauseQuantiles[0].Nanoseconds(),
+				"p25": stats.PauseQuantiles[1].Nanoseconds(),
+				"p50": stats.PauseQuantiles[2].Nanoseconds(),
+				"p75": stats.PauseQuantiles[3].Nanoseconds(),
+				"max": stats.PauseQuantiles[4].Nanoseconds(),
+			}
+		}
+		return newGenericDiagnostic(GCPausesDiagnosticType, value), nil
+	})
+}
+
+// BuildInfoCollector returns a Collector of the build information reported by debug.ReadBuildInfo, which includes the
+// versions of the main module and of all of its dependencies. Collection fails for binaries built without module
+// support.
+func BuildInfoCollector() Collector {
+	return CollectorFunc(func() (logging.Diagnostic, error) {
+		info, ok := debug.ReadBuildInfo()
+		if !ok {
+			return logging.Diagnostic{}, fmt.Errorf("build information is not available")
+		}
+		deps := make([]interface{}, 0, len(info.Deps))
+		for _, dep := range info.Deps {
+			deps = append(deps, moduleValue(dep))
+		}
+		settings := make(map[string]interface{}, len(info.Settings))
+		for _, setting := range info.Settings {
+			settings[setting.Key] = setting.Value
+		}
+		return newGenericDiagnostic(BuildInfoDiagnosticType, map[string]interface{}{
+			"goVersion": info.GoVersion,
+			"path":      info.Path,
+			"main":      moduleValue(&info.Main),
+			"deps":      deps,
+			"settings":  settings,
+		}), nil
+	})
+}
+
+func moduleValue(module *debug.Module) map[string]interface{} {
+	value := map[string]interface{}{
+		"path":    module.Path,
+		"version": module.Version,
+	}
+	if module.Sum != "" {
+		value["sum"] = module.Sum
+	}
+	if module.Replace != nil {
+		value["replace"] = moduleValue(module.Replace)
+	}
+	return value
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diag1log_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogDiagnostics(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := diag1log.WithLogger(context.Background(), diag1log.NewFromCreator(buf, wlog.NewJSONMarshalLoggerProvider().NewLogger))
+
+	err := diag1log.LogDiagnostics(ctx, []diag1log.Collector{
+		diag1log.MemStatsCollector(),
+		diag1log.CollectorFunc(func() (logging.Diagnostic, error) {
+			return logging.Diagnostic{}, fmt.Errorf("collector failed")
+		}),
+		diag1log.RuntimeMetricsCollector("/gc/cycles/total:gc-cycles", "/gc/pauses:seconds", "/unsupported:units"),
+		nil,
+		diag1log.GCPausesCollector(),
+		diag1log.BuildInfoCollector(),
+	}, diag1log.UnsafeParam("reason", "test"))
+	require.EqualError(t, err, "collector failed")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 4, len(entries))
+
+	var values []map[string]interface{}
+	for i, expectedType := range []string{
+		diag1log.MemStatsDiagnosticType,
+		diag1log.RuntimeMetricsDiagnosticType,
+		diag1log.GCPausesDiagnosticType,
+		diag1log.BuildInfoDiagnosticType,
+	} {
+		assert.Equal(t, "diagnostic.1", entries[i]["type"])
+		assert.Equal(t, map[string]interface{}{"reason": "test"}, entries[i]["unsafeParams"])
+		diagnostic, ok := entries[i]["diagnostic"].(map[string]interface{})
+		require.True(t, ok, "entry does not have a diagnostic: %v", entries[i])
+		assert.Equal(t, "generic", diagnostic["type"])
+		generic, ok := diagnostic["generic"].(map[string]interface{})
+		require.True(t, ok, "diagnostic is not generic: %v", diagnostic)
+		assert.Equal(t, expectedType, generic["diagnosticType"])
+		value, ok := generic["value"].(map[string]interface{})
+		require.True(t, ok, "diagnostic does not have a value: %v", generic)
+		values = append(values, value)
+	}
+
+	assert.Contains(t, values[0], "heapAlloc")
+	assert.Contains(t, values[0], "numGoroutine")
+	assert.Contains(t, values[1], "/gc/cycles/total:gc-cycles")
+	assert.NotContains(t, values[1], "/unsupported:units")
+	assert.Contains(t, values[1]["/gc/pauses:seconds"], "count")
+	assert.Contains(t, values[2], "pausesNs")
+	assert.NotEmpty(t, values[3]["goVersion"])
+	assert.Contains(t, values[3], "main")
+}
@@ -30,6 +30,14 @@ func (l *defaultLogger) Diagnostic(diagnostic logging.Diagnostic, params ...Para
 	l.logger.Log(ToParams(diagnostic, params)...)
 }
 
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
 func ToParams(diagnostic logging.Diagnostic, inParams []Param) []wlog.Param {
 	outParams := make([]wlog.Param, len(defaultTypeParam)+1+len(inParams))
 	copy(outParams, defaultTypeParam)
@@ -16,6 +16,7 @@ package diag1log
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -25,17 +26,103 @@ import (
 	"github.com/palantir/witchcraft-go-logging/internal/gopath"
 )
 
+// ThreadDumpParam configures how ThreadDumpV1FromGoroutines unmarshals a goroutine dump.
+type ThreadDumpParam interface {
+	apply(opts *threadDumpOptions)
+}
+
+type threadDumpParamFunc func(opts *threadDumpOptions)
+
+func (f threadDumpParamFunc) apply(opts *threadDumpOptions) {
+	f(opts)
+}
+
+type threadDumpOptions struct {
+	collapseIdenticalStacks bool
+}
+
+// CollapseIdenticalStacks configures ThreadDumpV1FromGoroutines to collapse goroutines that have the same status and
+// identical stack frames into a single thread. The collapsed thread keeps the name, ID and frames of the first such
+// goroutine, records the number of goroutines it represents in the "count" param and records the longest wait of the
+// collapsed goroutines in the "waitMinutes" param.
+func CollapseIdenticalStacks() ThreadDumpParam {
+	return threadDumpParamFunc(func(opts *threadDumpOptions) {
+		opts.collapseIdenticalStacks = true
+	})
+}
+
 // ThreadDumpV1FromGoroutines unmarshals a "goroutine dump" (as formatted by panic or the runtime package)
 // and returns a conjured logging.ThreadDumpV1 object.
-func ThreadDumpV1FromGoroutines(goroutinesContent []byte) logging.ThreadDumpV1 {
-	// Goroutines are separated by an empty line
-	goroutines := bytes.Split(goroutinesContent, []byte("\n\n"))
+//
+// In addition to the output of runtime.Stack, the output written by panic and fatal errors (including dumps of all
+// goroutines written when GOTRACEBACK=all) is supported: the panic message that precedes the first goroutine is not
+// included, but whether the panic was recovered and the signal that caused it are recorded in the params of the first
+// thread. Wait durations, "locked to thread" statuses and elided frames are recorded as thread params. Dumps that are
+// truncated, such as those that are cut off mid-frame, are unmarshaled as far as possible.
+func ThreadDumpV1FromGoroutines(goroutinesContent []byte, params ...ThreadDumpParam) logging.ThreadDumpV1 {
+	var opts threadDumpOptions
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(&opts)
+	}
 
-	threads := logging.ThreadDumpV1{Threads: make([]logging.ThreadInfoV1, len(goroutines))}
-	for i, goroutine := range goroutines {
-		threads.Threads[i] = unmarshalThreadDump(goroutine)
+	var (
+		threads        []logging.ThreadInfoV1
+		preambleLines  [][]byte
+		inGoroutine    bool
+		expectFileLine bool
+	)
+	for _, line := range bytes.Split(goroutinesContent, []byte("\n")) {
+		line = bytes.TrimRight(line, "\r")
+		if matches := titleLinePattern.FindSubmatch(line); matches != nil {
+			threads = append(threads, unmarshalTitleLine(matches))
+			inGoroutine, expectFileLine = true, false
+			continue
+		}
+		if len(bytes.TrimSpace(line)) == 0 || exitStatusPattern.Match(line) {
+			// Goroutines are separated by an empty line. "go run" writes the exit status directly after the last one.
+			inGoroutine = false
+			continue
+		}
+		if !inGoroutine {
+			// Lines before the first goroutine are written by panic. Other lines between goroutines are ignored.
+			if len(threads) == 0 {
+				preambleLines = append(preambleLines, line)
+			}
+			continue
+		}
+
+		thread := &threads[len(threads)-1]
+		switch {
+		case framesElidedPattern.Match(line):
+			thread.Params["framesElided"] = true
+			expectFileLine = false
+		case bytes.Equal(line, stackUnavailableLine):
+			thread.Params["stackUnavailable"] = true
+		case line[0] == '\t':
+			// File lines describe the function line that precedes them. A file line that does not follow a function
+			// line is recorded as a frame of its own.
+			if !expectFileLine {
+				thread.StackTrace = append(thread.StackTrace, logging.StackFrameV1{Params: make(map[string]interface{})})
+			}
+			unmarshalFileLine(line, &thread.StackTrace[len(thread.StackTrace)-1])
+			expectFileLine = false
+		default:
+			frame := logging.StackFrameV1{Params: make(map[string]interface{})}
+			unmarshalFuncLine(line, &frame)
+			thread.StackTrace = append(thread.StackTrace, frame)
+			expectFileLine = true
+		}
 	}
-	return threads
+	if len(threads) > 0 {
+		unmarshalPanicLines(preambleLines, &threads[0])
+	}
+	if opts.collapseIdenticalStacks {
+		threads = collapseIdenticalStacks(threads)
+	}
+	return logging.ThreadDumpV1{Threads: threads}
 }
 
 func ThreadDumpV1ToGoroutines(threads logging.ThreadDumpV1) string {
@@ -72,46 +159,117 @@ func ThreadDumpV1ToGoroutines(threads logging.ThreadDumpV1) string {
 	return out.String()
 }
 
-var titleLinePattern = regexp.MustCompile(`^(goroutine (\d+) \[([^]]+)]):$`)
-
-func unmarshalThreadDump(goroutine []byte) logging.ThreadInfoV1 {
-	lines := bytes.Split(bytes.TrimSpace(goroutine), []byte("\n"))
-	if len(lines) == 0 {
-		return logging.ThreadInfoV1{}
-	}
+var (
+	// titleLinePattern matches lines of the form 'goroutine 14 [select]:'. Tracebacks written with GOTRACEBACK=system
+	// or higher include additional fields between the ID and the status, such as 'goroutine 14 gp=0xc000007340 m=nil [select]:'.
+	titleLinePattern    = regexp.MustCompile(`^(goroutine (\d+)(?: [^[]*)? \[([^]]*)]):$`)
+	waitDurationPattern = regexp.MustCompile(`^(\d+) minutes$`)
+	framesElidedPattern = regexp.MustCompile(`^\.\.\.(?:additional|\d+) frames elided\.\.\.$`)
+	createdByPattern    = regexp.MustCompile(`^(.+) in goroutine (\d+)$`)
+	recoveredPattern    = regexp.MustCompile(` \[recovered(?:, repanicked)?]$`)
+	signalLinePattern   = regexp.MustCompile(`^\[signal (\w+)`)
+	exitStatusPattern   = regexp.MustCompile(`^exit status \d+$`)
 
-	info := logging.ThreadInfoV1{Params: make(map[string]interface{})}
+	stackUnavailableLine = []byte("goroutine running on other thread; stack unavailable")
+)
 
-	// The first line is of the form 'goroutine 14 [select]:'
-	titleLine := string(lines[0])
-	if matches := titleLinePattern.FindStringSubmatch(titleLine); len(matches) >= 4 {
-		info.Name = stringPtr(matches[1])
-		info.Id = stringToOptionalSafeLong(matches[2])
-		info.Params["status"] = matches[3]
+func unmarshalTitleLine(matches [][]byte) logging.ThreadInfoV1 {
+	info := logging.ThreadInfoV1{
+		Name:   stringPtr(string(matches[1])),
+		Id:     stringToOptionalSafeLong(string(matches[2])),
+		Params: make(map[string]interface{}),
 	}
+	// The status is of the form 'chan receive, 12 minutes, locked to thread', where all but the wait reason are optional
+	for i, field := range strings.Split(string(matches[3]), ", ") {
+		if i == 0 {
+			info.Params["status"] = field
+			continue
+		}
+		if field == "locked to thread" {
+			info.Params["lockedToThread"] = true
+		} else if waitMatches := waitDurationPattern.FindStringSubmatch(field); waitMatches != nil {
+			if minutes, err := strconv.Atoi(waitMatches[1]); err == nil {
+				info.Params["waitMinutes"] = minutes
+			}
+		}
+	}
+	return info
+}
 
-	// Stack frames start with the 2nd line
-	stackLines := lines[1:]
-	// Go through stack frames two lines at a time
-	for i := 0; i < len(stackLines); i += 2 {
-		funcLine := stackLines[i]
-		fileLine := stackLines[i+1]
-
-		frame := logging.StackFrameV1{Params: make(map[string]interface{})}
-
-		unmarshalFuncLine(funcLine, &frame)
-		unmarshalFileLine(fileLine, &frame)
+// unmarshalPanicLines records the information written by panic before the first goroutine in the params of thread.
+// The panic message itself is not recorded because it may contain unsafe information.
+func unmarshalPanicLines(lines [][]byte, thread *logging.ThreadInfoV1) {
+	for _, line := range lines {
+		line = bytes.TrimSpace(line)
+		if bytes.HasPrefix(line, []byte("panic: ")) {
+			thread.Params["panicked"] = true
+			if recoveredPattern.Match(line) {
+				thread.Params["recovered"] = true
+			}
+		} else if matches := signalLinePattern.FindSubmatch(line); matches != nil {
+			thread.Params["signal"] = string(matches[1])
+		}
+	}
+}
 
-		info.StackTrace = append(info.StackTrace, frame)
+func collapseIdenticalStacks(threads []logging.ThreadInfoV1) []logging.ThreadInfoV1 {
+	var collapsed []logging.ThreadInfoV1
+	var counts []int
+	indexByKey := make(map[string]int)
+	for _, thread := range threads {
+		key := stackKey(thread)
+		idx, ok := indexByKey[key]
+		if !ok {
+			indexByKey[key] = len(collapsed)
+			collapsed = append(collapsed, thread)
+			counts = append(counts, 1)
+			continue
+		}
+		counts[idx]++
+		if minutes, ok := thread.Params["waitMinutes"].(int); ok {
+			if prev, ok := collapsed[idx].Params["waitMinutes"].(int); !ok || minutes > prev {
+				collapsed[idx].Params["waitMinutes"] = minutes
+			}
+		}
+	}
+	for i := range collapsed {
+		collapsed[i].Params["count"] = counts[i]
 	}
+	return collapsed
+}
 
-	return info
+// stackKey returns a key that is equal for threads with the same status and identical stack frames.
+func stackKey(thread logging.ThreadInfoV1) string {
+	var key strings.Builder
+	_, _ = fmt.Fprintf(&key, "%v|%v\n", thread.Params["status"], thread.Params["lockedToThread"])
+	for _, frame := range thread.StackTrace {
+		if frame.Procedure != nil {
+			key.WriteString(*frame.Procedure)
+		}
+		key.WriteByte('|')
+		if frame.File != nil {
+			key.WriteString(*frame.File)
+		}
+		key.WriteByte('|')
+		if frame.Line != nil {
+			key.WriteString(strconv.Itoa(*frame.Line))
+		}
+		key.WriteByte('\n')
+	}
+	return key.String()
 }
 
 func unmarshalFuncLine(funcLine []byte, frame *logging.StackFrameV1) {
 	if bytes.HasPrefix(funcLine, []byte("created by ")) {
 		// creators do not include arguments
 		procedure := strings.TrimPrefix(string(funcLine), "created by ")
+		// since go1.21, creators are suffixed with the ID of the creating goroutine
+		if matches := createdByPattern.FindStringSubmatch(procedure); matches != nil {
+			procedure = matches[1]
+			if parentID := stringToOptionalSafeLong(matches[2]); parentID != nil {
+				frame.Params["parentGoroutineId"] = *parentID
+			}
+		}
 		frame.Procedure = &procedure
 		frame.Params["goroutineCreator"] = true
 		return
@@ -127,8 +285,11 @@ func unmarshalFuncLine(funcLine []byte, frame *logging.StackFrameV1) {
 func unmarshalFileLine(fileLine []byte, frame *logging.StackFrameV1) {
 	segments := strings.Split(string(bytes.TrimSpace(fileLine)), " +")
 
+	// GOTRACEBACK=system and higher append the frame, stack and program counters to the address
 	if len(segments) > 1 {
-		frame.Address = &segments[1]
+		if fields := strings.Fields(segments[1]); len(fields) > 0 {
+			frame.Address = &fields[0]
+		}
 	}
 
 	sepIdx := strings.LastIndex(segments[0], ":")
@@ -20,6 +20,7 @@ import (
 	"github.com/palantir/pkg/safelong"
 	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -113,6 +114,165 @@ created by net/http.(*Transport).dialConn(...)
 				},
 			},
 		},
+		{
+			Name: "wait duration, locked to thread and elided frames",
+			Input: `goroutine 7 [chan receive, 12 minutes, locked to thread]:
+main.worker(0xc000010000)
+	/go/src/example.com/main.go:20 +0x25
+...additional frames elided...
+created by main.main in goroutine 1
+	/go/src/example.com/main.go:12 +0x3e
+`,
+			Marshaled: `goroutine 7 [chan receive, 12 minutes, locked to thread]:
+main.worker(...)
+	example.com/main.go:20 +0x25
+created by main.main(...)
+	example.com/main.go:12 +0x3e
+`,
+			Expected: logging.ThreadDumpV1{
+				Threads: []logging.ThreadInfoV1{
+					{
+						Name: strPtr("goroutine 7 [chan receive, 12 minutes, locked to thread]"),
+						Id:   safelongPtr(7),
+						Params: map[string]interface{}{
+							"status":         "chan receive",
+							"waitMinutes":    12,
+							"lockedToThread": true,
+							"framesElided":   true,
+						},
+						StackTrace: []logging.StackFrameV1{
+							{
+								Address:   strPtr("0x25"),
+								Procedure: strPtr("main.worker"),
+								File:      strPtr("example.com/main.go"),
+								Line:      intPtr(20),
+								Params:    map[string]interface{}{},
+							},
+							{
+								Address:   strPtr("0x3e"),
+								Procedure: strPtr("main.main"),
+								File:      strPtr("example.com/main.go"),
+								Line:      intPtr(12),
+								Params: map[string]interface{}{
+									"goroutineCreator":  true,
+									"parentGoroutineId": *safelongPtr(1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "truncated dump",
+			Input: `goroutine 3 [select]:
+main.loop()
+	/go/src/example.com/main.go:30 +0x10
+main.run(...)
+	/go/src/example.com/main.go:25
+	/go/src/example.com/orphan.go:5 +0x1
+main.tru`,
+			Marshaled: `goroutine 3 [select]:
+main.loop(...)
+	example.com/main.go:30 +0x10
+main.run(...)
+	example.com/main.go:25
+	example.com/orphan.go:5 +0x1
+`,
+			Expected: logging.ThreadDumpV1{
+				Threads: []logging.ThreadInfoV1{
+					{
+						Name:   strPtr("goroutine 3 [select]"),
+						Id:     safelongPtr(3),
+						Params: map[string]interface{}{"status": "select"},
+						StackTrace: []logging.StackFrameV1{
+							{
+								Address:   strPtr("0x10"),
+								Procedure: strPtr("main.loop"),
+								File:      strPtr("example.com/main.go"),
+								Line:      intPtr(30),
+								Params:    map[string]interface{}{},
+							},
+							{
+								Procedure: strPtr("main.run"),
+								File:      strPtr("example.com/main.go"),
+								Line:      intPtr(25),
+								Params:    map[string]interface{}{},
+							},
+							{
+								Address: strPtr("0x1"),
+								File:    strPtr("example.com/orphan.go"),
+								Line:    intPtr(5),
+								Params:  map[string]interface{}{},
+							},
+							{
+								Params: map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "panic with GOTRACEBACK=all",
+			Input: `panic: first [recovered]
+	panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x47e4b5]
+
+goroutine 1 gp=0xc000002380 m=0 mp=0x5a3b40 [running]:
+main.main()
+	/go/src/example.com/main.go:8 +0x15 fp=0xc000072f50 sp=0xc000072f28 pc=0x47e4b5
+
+goroutine 2 [force gc (idle)]:
+runtime.gopark(0x0?, 0x0?, 0x0?, 0x0?, 0x0?)
+	/usr/local/go/src/runtime/proc.go:402 +0xce
+exit status 2
+`,
+			Marshaled: `goroutine 1 gp=0xc000002380 m=0 mp=0x5a3b40 [running]:
+main.main(...)
+	example.com/main.go:8 +0x15
+goroutine 2 [force gc (idle)]:
+runtime.gopark(...)
+	runtime/proc.go:402 +0xce
+`,
+			Expected: logging.ThreadDumpV1{
+				Threads: []logging.ThreadInfoV1{
+					{
+						Name: strPtr("goroutine 1 gp=0xc000002380 m=0 mp=0x5a3b40 [running]"),
+						Id:   safelongPtr(1),
+						Params: map[string]interface{}{
+							"status":    "running",
+							"panicked":  true,
+							"recovered": true,
+							"signal":    "SIGSEGV",
+						},
+						StackTrace: []logging.StackFrameV1{
+							{
+								Address:   strPtr("0x15"),
+								Procedure: strPtr("main.main"),
+								File:      strPtr("example.com/main.go"),
+								Line:      intPtr(8),
+								Params:    map[string]interface{}{},
+							},
+						},
+					},
+					{
+						Name:   strPtr("goroutine 2 [force gc (idle)]"),
+						Id:     safelongPtr(2),
+						Params: map[string]interface{}{"status": "force gc (idle)"},
+						StackTrace: []logging.StackFrameV1{
+							{
+								Address:   strPtr("0xce"),
+								Procedure: strPtr("runtime.gopark"),
+								File:      strPtr("runtime/proc.go"),
+								Line:      intPtr(402),
+								Params:    map[string]interface{}{},
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(test.Name, func(t *testing.T) {
 			dump := diag1log.ThreadDumpV1FromGoroutines([]byte(test.Input))
@@ -123,6 +283,33 @@ created by net/http.(*Transport).dialConn(...)
 	}
 }
 
+func TestThreadDumpV1FromGoroutinesCollapseIdenticalStacks(t *testing.T) {
+	input := `goroutine 1 [running]:
+main.main()
+	/go/src/example.com/main.go:8 +0x15
+
+goroutine 5 [chan receive, 3 minutes]:
+main.worker()
+	/go/src/example.com/main.go:20 +0x25
+
+goroutine 6 [chan receive, 12 minutes]:
+main.worker()
+	/go/src/example.com/main.go:20 +0x25
+
+goroutine 7 [select]:
+main.worker()
+	/go/src/example.com/main.go:20 +0x25
+`
+	dump := diag1log.ThreadDumpV1FromGoroutines([]byte(input), diag1log.CollapseIdenticalStacks())
+	require.Len(t, dump.Threads, 3)
+	assert.Equal(t, safelongPtr(1), dump.Threads[0].Id)
+	assert.Equal(t, map[string]interface{}{"status": "running", "count": 1}, dump.Threads[0].Params)
+	assert.Equal(t, safelongPtr(5), dump.Threads[1].Id)
+	assert.Equal(t, map[string]interface{}{"status": "chan receive", "waitMinutes": 12, "count": 2}, dump.Threads[1].Params)
+	assert.Equal(t, safelongPtr(7), dump.Threads[2].Id)
+	assert.Equal(t, map[string]interface{}{"status": "select", "count": 1}, dump.Threads[2].Params)
+}
+
 func strPtr(s string) *string { return &s }
 
 func intPtr(i int) *int { return &i }
@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evt1logtests
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type TestCase struct {
+	Name         string
+	EventName    string
+	EventType    string
+	Values       map[string]interface{}
+	UID          string
+	SID          string
+	TokenID      string
+	UnsafeParams map[string]interface{}
+	JSONMatcher  objmatcher.MapMatcher
+}
+
+func (tc TestCase) Params() []evt1log.Param {
+	return []evt1log.Param{
+		evt1log.Values(tc.Values),
+		evt1log.UID(tc.UID),
+		evt1log.SID(tc.SID),
+		evt1log.TokenID(tc.TokenID),
+		evt1log.UnsafeParams(tc.UnsafeParams),
+	}
+}
+
+func TestCases() []TestCase {
+	return []TestCase{
+		{
+			Name:      "basic event log entry",
+			EventName: "com.palantir.foundry.build.buildstarted",
+			EventType: "counter",
+			UID:       "user-1",
+			SID:       "session-1",
+			Values: map[string]interface{}{
+				"dataset": "my-cool-dataset",
+			},
+			TokenID: "X-Y-Z",
+			UnsafeParams: map[string]interface{}{
+				"Password": "HelloWorld!",
+			},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":      objmatcher.NewEqualsMatcher("event.1"),
+				"eventName": objmatcher.NewEqualsMatcher("com.palantir.foundry.build.buildstarted"),
+				"eventType": objmatcher.NewEqualsMatcher("counter"),
+				"time":      objmatcher.NewRegExpMatcher(".+"),
+				"uid":       objmatcher.NewEqualsMatcher("user-1"),
+				"sid":       objmatcher.NewEqualsMatcher("session-1"),
+				"values": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"dataset": objmatcher.NewEqualsMatcher("my-cool-dataset"),
+				}),
+				"tokenId": objmatcher.NewEqualsMatcher("X-Y-Z"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"Password": objmatcher.NewEqualsMatcher("HelloWorld!"),
+				}),
+			},
+		},
+	}
+}
+
+func JSONTestSuite(t *testing.T, loggerProvider func(w io.Writer) evt1log.Logger) {
+	jsonOutputTests(t, loggerProvider)
+	valueIsntOverwrittenByValues(t, loggerProvider)
+	extraValuesIndependentAcrossCalls(t, loggerProvider)
+}
+
+func jsonOutputTests(t *testing.T, loggerProvider func(w io.Writer) evt1log.Logger) {
+	for i, tc := range TestCases() {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := loggerProvider(buf)
+
+			logger.Event(tc.EventName, tc.EventType, tc.Params()...)
+
+			gotEventLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := safejson.Unmarshal(logEntry, &gotEventLog)
+			require.NoError(t, err, "Case %d: %s\nEvent log line is not a valid map: %v", i, tc.Name, string(logEntry))
+
+			assert.NoError(t, tc.JSONMatcher.Matches(gotEventLog), "Case %d: %s", i, tc.Name)
+		})
+	}
+}
+
+// Verifies that if different parameters are specified using Value and Values params, all of the values are present in
+// the final output (that is, these parameters should be additive).
+func valueIsntOverwrittenByValues(t *testing.T, loggerProvider func(w io.Writer) evt1log.Logger) {
+	t.Run("Value and Values params are additive", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := loggerProvider(&buf)
+
+		logger.Event("event", "counter", evt1log.Value("key", "value"), evt1log.Values(map[string]interface{}{"keys": "values"}))
+
+		gotEventLog := map[string]interface{}{}
+		logEntry := buf.Bytes()
+		err := safejson.Unmarshal(logEntry, &gotEventLog)
+		require.NoError(t, err, "Event log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"eventName": objmatcher.NewEqualsMatcher("event"),
+			"eventType": objmatcher.NewEqualsMatcher("counter"),
+			"time":      objmatcher.NewRegExpMatcher(".+"),
+			"type":      objmatcher.NewEqualsMatcher("event.1"),
+			"values": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key":  objmatcher.NewEqualsMatcher("value"),
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotEventLog))
+	})
+}
+
+// Verifies that parameters remain separate between different logger calls (ensures there is not a bug where parameters
+// are modified by making a logger call).
+func extraValuesIndependentAcrossCalls(t *testing.T, loggerProvider func(w io.Writer) evt1log.Logger) {
+	t.Run("Value and Values params stay separate across logger calls", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := loggerProvider(&buf)
+
+		reusedParams := evt1log.Values(map[string]interface{}{"keys": "values"})
+		logger.Event("event", "counter", reusedParams, evt1log.Value("key", "value"))
+		gotEventLog := map[string]interface{}{}
+		logEntry := buf.Bytes()
+		err := safejson.Unmarshal(logEntry, &gotEventLog)
+		require.NoError(t, err, "Event log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"eventName": objmatcher.NewEqualsMatcher("event"),
+			"eventType": objmatcher.NewEqualsMatcher("counter"),
+			"time":      objmatcher.NewRegExpMatcher(".+"),
+			"type":      objmatcher.NewEqualsMatcher("event.1"),
+			"values": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"key":  objmatcher.NewEqualsMatcher("value"),
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotEventLog))
+
+		buf.Reset()
+		logger.Event("event", "counter", reusedParams)
+
+		gotEventLog = map[string]interface{}{}
+		logEntry = buf.Bytes()
+		err = safejson.Unmarshal(logEntry, &gotEventLog)
+		require.NoError(t, err, "Event log line is not a valid map: %v", string(logEntry))
+
+		assert.NoError(t, objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"eventName": objmatcher.NewEqualsMatcher("event"),
+			"eventType": objmatcher.NewEqualsMatcher("counter"),
+			"time":      objmatcher.NewRegExpMatcher(".+"),
+			"type":      objmatcher.NewEqualsMatcher("event.1"),
+			"values": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"keys": objmatcher.NewEqualsMatcher("values"),
+			}),
+		}).Matches(gotEventLog))
+	})
+}
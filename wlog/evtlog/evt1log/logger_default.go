@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evt1log
+
+import (
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+type defaultLogger struct {
+	logger wlog.Logger
+}
+
+func (l *defaultLogger) Event(name, eventType string, params ...Param) {
+	l.logger.Log(ToParams(name, eventType, params)...)
+}
+
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
+func ToParams(evtName, evtType string, inParams []Param) []wlog.Param {
+	outParams := make([]wlog.Param, len(defaultTypeParam)+2+len(inParams))
+	copy(outParams, defaultTypeParam)
+	outParams[len(defaultTypeParam)] = wlog.NewParam(eventNameParam(evtName).apply)
+	outParams[len(defaultTypeParam)+1] = wlog.NewParam(eventTypeParam(evtType).apply)
+	for idx := range inParams {
+		outParams[len(defaultTypeParam)+2+idx] = wlog.NewParam(inParams[idx].apply)
+	}
+	return outParams
+}
+
+var defaultTypeParam = []wlog.Param{
+	wlog.NewParam(func(entry wlog.LogEntry) {
+		entry.StringValue(wlog.TypeKey, TypeValue)
+		entry.StringValue(wlog.TimeKey, time.Now().Format(time.RFC3339Nano))
+	}),
+}
@@ -51,3 +51,11 @@ func (l *warnLogger) Event(name string, params ...Param) {
 	NewFromCreator(buf, l.creator).Event(name, params...)
 	_, _ = fmt.Fprintln(l.w, wloginternal.WarnLoggerOutput("evt2log", buf.String(), 2))
 }
+
+func (l *warnLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
+func (l *warnLogger) Close() error {
+	return l.Flush()
+}
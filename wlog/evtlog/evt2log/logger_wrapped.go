@@ -14,6 +14,10 @@
 
 package evt2log
 
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
 type wrappedLogger struct {
 	logger Logger
 	params []Param
@@ -22,3 +26,11 @@ type wrappedLogger struct {
 func (w *wrappedLogger) Event(name string, params ...Param) {
 	w.logger.Event(name, append(w.params, params...)...)
 }
+
+func (w *wrappedLogger) Flush() error {
+	return wlog.Flush(w.logger)
+}
+
+func (w *wrappedLogger) Close() error {
+	return wlog.Close(w.logger)
+}
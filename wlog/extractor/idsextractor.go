@@ -22,7 +22,11 @@ type IDsFromRequest interface {
 	ExtractIDs(req *http.Request) map[string]string
 }
 
-func newCompoundExtractor(extractors ...IDsFromRequest) IDsFromRequest {
+// NewCompoundExtractor returns an extractor that returns the IDs extracted by all of the provided extractors. If
+// multiple extractors extract a value for the same key, the value extracted by the extractor that appears later in the
+// list is used. Empty values never replace values extracted by earlier extractors, so extractors for the same key can
+// be listed in increasing order of preference.
+func NewCompoundExtractor(extractors ...IDsFromRequest) IDsFromRequest {
 	return compoundExtractor(extractors)
 }
 
@@ -32,6 +36,9 @@ func (e compoundExtractor) ExtractIDs(req *http.Request) map[string]string {
 	out := make(map[string]string)
 	for _, currExtractor := range e {
 		for k, v := range currExtractor.ExtractIDs(req) {
+			if _, ok := out[k]; ok && v == "" {
+				continue
+			}
 			out[k] = v
 		}
 	}
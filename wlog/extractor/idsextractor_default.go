@@ -14,8 +14,10 @@
 
 package extractor
 
+// NewDefaultIDsExtractor returns an extractor that extracts the UID, SID, token ID and organization ID from the JWT used
+// as the bearer token in the "Authorization" header of the request and the trace ID from its "X-B3-TraceId" header.
 func NewDefaultIDsExtractor() IDsFromRequest {
-	return newCompoundExtractor(
+	return NewCompoundExtractor(
 		newIDsFromJWTExtractor(),
 		newTraceIDFromHeaderExtractor(),
 	)
@@ -0,0 +1,57 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"net/http"
+)
+
+const (
+	RequestIDKey = "requestId"
+)
+
+// NewHeaderExtractor returns an extractor that sets the provided key to the value of the first of the provided headers
+// of the request that has a value.
+func NewHeaderExtractor(key string, headerNames ...string) IDsFromRequest {
+	return &headerExtractor{
+		key:         key,
+		headerNames: headerNames,
+	}
+}
+
+// NewRequestIDExtractor returns an extractor that sets the RequestIDKey key to the value of the first of the provided
+// headers of the request that has a value. If no headers are provided, the "X-Request-Id" header is used.
+func NewRequestIDExtractor(headerNames ...string) IDsFromRequest {
+	if len(headerNames) == 0 {
+		headerNames = []string{"X-Request-Id"}
+	}
+	return NewHeaderExtractor(RequestIDKey, headerNames...)
+}
+
+type headerExtractor struct {
+	key         string
+	headerNames []string
+}
+
+func (e *headerExtractor) ExtractIDs(req *http.Request) map[string]string {
+	for _, headerName := range e.headerNames {
+		if val := req.Header.Get(headerName); val != "" {
+			return map[string]string{
+				e.key: val,
+			}
+		}
+	}
+	return nil
+}
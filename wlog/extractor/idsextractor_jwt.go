@@ -31,59 +31,130 @@ const (
 	OrgIDKey   = "orgId"
 )
 
-// newIDsFromJWTExtractor creates an extractor that sets the UIDKey, SIDKey and TokenIDKey keys to have the values
-// parsed from the JWT used as the bearer token in the "Authorization" header of the request. The JWT's "sub" field is
-// used as the UID, the "sid" field is used as the SID and the "jti" field is used as the tokenID.
+// JWTExtractorParam configures an extractor returned by NewJWTExtractor.
+type JWTExtractorParam interface {
+	apply(e *jwtRequestIDsExtractor)
+}
+
+type jwtExtractorParamFunc func(e *jwtRequestIDsExtractor)
+
+func (f jwtExtractorParamFunc) apply(e *jwtRequestIDsExtractor) {
+	f(e)
+}
+
+// JWTClaim configures the extractor to set the provided key to the value of the provided claim of the JWT. Replaces
+// the claim that is used for the key by default, if any. Claims that are Base64-encoded UUIDs are converted to their
+// string representation.
+func JWTClaim(claim, key string) JWTExtractorParam {
+	return jwtExtractorParamFunc(func(e *jwtRequestIDsExtractor) {
+		e.claims[key] = claim
+	})
+}
+
+// JWTFromHeader configures the extractor to read the JWT from the provided header of the request. A "Bearer " prefix of
+// the header value is removed. If multiple sources are configured, the JWT is read from the first source that has a
+// value. If no sources are configured, the JWT is read from the "Authorization" header.
+func JWTFromHeader(headerName string) JWTExtractorParam {
+	return jwtExtractorParamFunc(func(e *jwtRequestIDsExtractor) {
+		e.sources = append(e.sources, func(req *http.Request) string {
+			return strings.TrimPrefix(req.Header.Get(headerName), bearerTokenPrefix)
+		})
+	})
+}
+
+// JWTFromCookie configures the extractor to read the JWT from the cookie of the request with the provided name. If
+// multiple sources are configured, the JWT is read from the first source that has a value. If no sources are
+// configured, the JWT is read from the "Authorization" header.
+func JWTFromCookie(cookieName string) JWTExtractorParam {
+	return jwtExtractorParamFunc(func(e *jwtRequestIDsExtractor) {
+		e.sources = append(e.sources, func(req *http.Request) string {
+			cookie, err := req.Cookie(cookieName)
+			if err != nil {
+				return ""
+			}
+			return cookie.Value
+		})
+	})
+}
+
+// NewJWTExtractor returns an extractor that sets keys to the values of claims of the JWT of the request. By default,
+// the JWT is the bearer token in the "Authorization" header of the request and the "sub", "sid", "jti" and "org" claims
+// are used as the values of the UIDKey, SIDKey, TokenIDKey and OrgIDKey keys respectively. Note that the signature of
+// the JWT is not verified, so the extracted values should only be used for logging.
+func NewJWTExtractor(params ...JWTExtractorParam) IDsFromRequest {
+	e := &jwtRequestIDsExtractor{
+		claims: map[string]string{
+			// "sub" = "subject" field, which is used as the UID
+			UIDKey: "sub",
+			// "sid" is used to store the session ID
+			SIDKey: "sid",
+			// "jti" is used to store the token ID
+			TokenIDKey: "jti",
+			// "org" is used to store the organization ID
+			OrgIDKey: "org",
+		},
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(e)
+	}
+	if len(e.sources) == 0 {
+		JWTFromHeader("Authorization").apply(e)
+	}
+	return e
+}
+
+// newIDsFromJWTExtractor creates an extractor that sets the UIDKey, SIDKey, TokenIDKey and OrgIDKey keys to have the
+// values parsed from the JWT used as the bearer token in the "Authorization" header of the request. The JWT's "sub"
+// field is used as the UID, the "sid" field is used as the SID, the "jti" field is used as the tokenID and the "org"
+// field is used as the orgID.
 func newIDsFromJWTExtractor() IDsFromRequest {
-	return &jwtRequestIDsExtractor{}
+	return NewJWTExtractor()
 }
 
-type jwtRequestIDsExtractor struct{}
+const bearerTokenPrefix = "Bearer "
 
-func (e *jwtRequestIDsExtractor) ExtractIDs(req *http.Request) map[string]string {
-	const bearerTokenPrefix = "Bearer "
+type jwtRequestIDsExtractor struct {
+	// claims maps the extracted keys to the claims used as their values
+	claims  map[string]string
+	sources []func(req *http.Request) string
+}
 
-	var uid, sid, tokenID, orgID string
-	authContent := req.Header.Get("Authorization")
-	if strings.HasPrefix(authContent, bearerTokenPrefix) {
-		uid, sid, tokenID, orgID, _ = idsFromJWT(authContent[len(bearerTokenPrefix):])
+func (e *jwtRequestIDsExtractor) ExtractIDs(req *http.Request) map[string]string {
+	var claims map[string]interface{}
+	for _, source := range e.sources {
+		if jwtContent := source(req); jwtContent != "" {
+			claims, _ = claimsFromJWT(jwtContent)
+			break
+		}
 	}
-	return map[string]string{
-		UIDKey:     uid,
-		SIDKey:     sid,
-		TokenIDKey: tokenID,
-		OrgIDKey:   orgID,
+	out := make(map[string]string, len(e.claims))
+	for key, claim := range e.claims {
+		out[key] = getMapUUIDStringVal(claims, claim)
 	}
+	return out
 }
 
-// idsFromJWT returns the uid, sid and tokenID in the provided JWT. Note that signature verification is not performed on
-// the JWT, so the returned values should not be considered secure or used for security purposes. However, the values
-// are considered acceptable for use in logging.
-func idsFromJWT(jwtContent string) (uid string, sid string, tokenID string, orgID string, err error) {
+// claimsFromJWT returns the claims in the provided JWT. Note that signature verification is not performed on the JWT,
+// so the returned values should not be considered secure or used for security purposes. However, the values are
+// considered acceptable for use in logging.
+func claimsFromJWT(jwtContent string) (map[string]interface{}, error) {
 	parts := strings.Split(jwtContent, ".")
 	if len(parts) != 3 {
-		return "", "", "", "", fmt.Errorf("JWT must have 3 '.'-separated parts, but had %d: %q", len(parts), jwtContent)
+		return nil, fmt.Errorf("JWT must have 3 '.'-separated parts, but had %d: %q", len(parts), jwtContent)
 	}
 	bytes, err := base64.RawURLEncoding.DecodeString(parts[1])
 	if err != nil {
-		return "", "", "", "", fmt.Errorf("failed to decode JWT content %q as Base64 URL-encoded string: %v", parts[1], err)
+		return nil, fmt.Errorf("failed to decode JWT content %q as Base64 URL-encoded string: %v", parts[1], err)
 	}
 
 	var jsonMap map[string]interface{}
 	if err := json.Unmarshal(bytes, &jsonMap); err != nil {
-		return "", "", "", "", fmt.Errorf("failed to decode JWT content %s as JSON: %v", string(bytes), err)
+		return nil, fmt.Errorf("failed to decode JWT content %s as JSON: %v", string(bytes), err)
 	}
-
-	// "sub" = "subject" field, which is used as the UID
-	uid = getMapUUIDStringVal(jsonMap, "sub")
-	// "sid" is used to store the session ID
-	sid = getMapUUIDStringVal(jsonMap, "sid")
-	// "jti" is used to store the token ID
-	tokenID = getMapUUIDStringVal(jsonMap, "jti")
-	// "org" is used to store the organization ID
-	orgID = getMapUUIDStringVal(jsonMap, "org")
-
-	return uid, sid, tokenID, orgID, nil
+	return jsonMap, nil
 }
 
 func getMapUUIDStringVal(m map[string]interface{}, key string) string {
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extractor
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompoundExtractor(t *testing.T) {
+	req := &http.Request{Header: http.Header{
+		"Traceparent":  {"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		"B3":           {"80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-1"},
+		"X-B3-Traceid": {"463ac35c9f6413ad"},
+		"X-Request-Id": {"request-1"},
+	}}
+	ids := NewCompoundExtractor(
+		NewB3MultiHeaderExtractor(),
+		NewB3SingleHeaderExtractor(),
+		NewTraceParentExtractor(),
+		NewRequestIDExtractor(),
+	).ExtractIDs(req)
+	assert.Equal(t, map[string]string{
+		TraceIDKey:   "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanIDKey:    "00f067aa0ba902b7",
+		RequestIDKey: "request-1",
+	}, ids)
+
+	// empty values do not replace values extracted by earlier extractors
+	req.Header.Del("Traceparent")
+	req.Header.Del("B3")
+	ids = NewCompoundExtractor(
+		NewB3MultiHeaderExtractor(),
+		NewB3SingleHeaderExtractor(),
+		NewTraceParentExtractor(),
+	).ExtractIDs(req)
+	assert.Equal(t, map[string]string{
+		TraceIDKey: "463ac35c9f6413ad",
+		SpanIDKey:  "",
+	}, ids)
+}
+
+func TestJWTExtractor(t *testing.T) {
+	jwt := "header." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"vp9kXVLgSem6MdsyknYV2w==","user_id":"user-1","tenant":"tenant-1"}`)) + ".signature"
+	for _, tc := range []struct {
+		name   string
+		params []JWTExtractorParam
+		header http.Header
+		want   map[string]string
+	}{
+		{
+			name:   "default claims from bearer token",
+			header: http.Header{"Authorization": {"Bearer " + jwt}},
+			want: map[string]string{
+				UIDKey:     "be9f645d-52e0-49e9-ba31-db32927615db",
+				SIDKey:     "",
+				TokenIDKey: "",
+				OrgIDKey:   "",
+			},
+		},
+		{
+			name:   "custom claims from cookie",
+			params: []JWTExtractorParam{JWTFromCookie("AUTH_TOKEN"), JWTClaim("user_id", UIDKey), JWTClaim("tenant", "tenantId")},
+			header: http.Header{"Cookie": {"OTHER=val; AUTH_TOKEN=" + jwt}},
+			want: map[string]string{
+				UIDKey:     "user-1",
+				SIDKey:     "",
+				TokenIDKey: "",
+				OrgIDKey:   "",
+				"tenantId": "tenant-1",
+			},
+		},
+		{
+			name:   "first source with a value is used",
+			params: []JWTExtractorParam{JWTFromHeader("X-Auth-Token"), JWTFromHeader("Authorization")},
+			header: http.Header{"Authorization": {"Bearer invalid"}, "X-Auth-Token": {jwt}},
+			want: map[string]string{
+				UIDKey:     "be9f645d-52e0-49e9-ba31-db32927615db",
+				SIDKey:     "",
+				TokenIDKey: "",
+				OrgIDKey:   "",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewJWTExtractor(tc.params...).ExtractIDs(&http.Request{Header: tc.header})
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestTraceParentExtractor(t *testing.T) {
+	for _, tc := range []struct {
+		traceParent string
+		want        map[string]string
+	}{
+		{
+			traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want:        map[string]string{TraceIDKey: "4bf92f3577b34da6a3ce929d0e0e4736", SpanIDKey: "00f067aa0ba902b7"},
+		},
+		{
+			// future versions may have additional fields
+			traceParent: "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			want:        map[string]string{TraceIDKey: "4bf92f3577b34da6a3ce929d0e0e4736", SpanIDKey: "00f067aa0ba902b7"},
+		},
+		{traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra"},
+		{traceParent: "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+		{traceParent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{traceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01"},
+		{traceParent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01"},
+		{traceParent: "00-4bf92f3577b34da6-00f067aa0ba902b7-01"},
+		{traceParent: ""},
+	} {
+		got := NewTraceParentExtractor().ExtractIDs(&http.Request{Header: http.Header{"Traceparent": {tc.traceParent}}})
+		assert.Equal(t, tc.want, got, "traceparent %q", tc.traceParent)
+	}
+}
+
+func TestB3SingleHeaderExtractor(t *testing.T) {
+	for _, tc := range []struct {
+		b3   string
+		want map[string]string
+	}{
+		{
+			b3:   "80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-1-05e3ac9a4f6e3b90",
+			want: map[string]string{TraceIDKey: "80f198ee56343ba864fe8b2a57d3eff7", SpanIDKey: "e457b5a2e4d86bd1"},
+		},
+		{
+			b3:   "64fe8b2a57d3eff7-e457b5a2e4d86bd1",
+			want: map[string]string{TraceIDKey: "64fe8b2a57d3eff7", SpanIDKey: "e457b5a2e4d86bd1"},
+		},
+		{b3: "1"},
+		{b3: "d"},
+		{b3: "64fe8b2a57d3eff7-e457b5a2e4d86bd"},
+		{b3: "not-valid"},
+	} {
+		got := NewB3SingleHeaderExtractor().ExtractIDs(&http.Request{Header: http.Header{"B3": {tc.b3}}})
+		assert.Equal(t, tc.want, got, "b3 %q", tc.b3)
+	}
+}
+
+func TestRequestIDExtractor(t *testing.T) {
+	req := &http.Request{Header: http.Header{"X-Correlation-Id": {"correlation-1"}}}
+	assert.Nil(t, NewRequestIDExtractor().ExtractIDs(req))
+	assert.Equal(t, map[string]string{RequestIDKey: "correlation-1"}, NewRequestIDExtractor("X-Request-Id", "X-Correlation-Id").ExtractIDs(req))
+}
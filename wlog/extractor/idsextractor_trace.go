@@ -16,10 +16,12 @@ package extractor
 
 import (
 	"net/http"
+	"strings"
 )
 
 const (
 	TraceIDKey = "traceId"
+	SpanIDKey  = "spanId"
 )
 
 // newTraceIDFromHeaderExtractor returns a map with the TraceIDKey key with the value stored in the "X-B3-TraceId"
@@ -35,3 +37,89 @@ func (e *traceIDExtractor) ExtractIDs(req *http.Request) map[string]string {
 		TraceIDKey: req.Header.Get("X-B3-TraceId"),
 	}
 }
+
+// NewB3MultiHeaderExtractor returns an extractor that sets the TraceIDKey and SpanIDKey keys to the values of the
+// "X-B3-TraceId" and "X-B3-SpanId" headers of the request.
+func NewB3MultiHeaderExtractor() IDsFromRequest {
+	return &b3MultiHeaderExtractor{}
+}
+
+type b3MultiHeaderExtractor struct{}
+
+func (e *b3MultiHeaderExtractor) ExtractIDs(req *http.Request) map[string]string {
+	return map[string]string{
+		TraceIDKey: req.Header.Get("X-B3-TraceId"),
+		SpanIDKey:  req.Header.Get("X-B3-SpanId"),
+	}
+}
+
+// NewB3SingleHeaderExtractor returns an extractor that sets the TraceIDKey and SpanIDKey keys to the trace ID and span
+// ID in the "b3" header of the request, which has the format "{TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}" where
+// the last two fields are optional. No values are set if the header only contains a sampling state or is malformed.
+func NewB3SingleHeaderExtractor() IDsFromRequest {
+	return &b3SingleHeaderExtractor{}
+}
+
+type b3SingleHeaderExtractor struct{}
+
+func (e *b3SingleHeaderExtractor) ExtractIDs(req *http.Request) map[string]string {
+	parts := strings.Split(req.Header.Get("b3"), "-")
+	if len(parts) < 2 || len(parts) > 4 {
+		return nil
+	}
+	traceID, spanID := parts[0], parts[1]
+	if (len(traceID) != 16 && len(traceID) != 32) || !isLowerHex(traceID) || len(spanID) != 16 || !isLowerHex(spanID) {
+		return nil
+	}
+	return map[string]string{
+		TraceIDKey: traceID,
+		SpanIDKey:  spanID,
+	}
+}
+
+// NewTraceParentExtractor returns an extractor that sets the TraceIDKey and SpanIDKey keys to the trace ID and parent
+// ID in the W3C "traceparent" header of the request, which has the format "{version}-{trace-id}-{parent-id}-{flags}".
+// No values are set if the header is malformed or contains an all-zero trace or parent ID.
+func NewTraceParentExtractor() IDsFromRequest {
+	return &traceParentExtractor{}
+}
+
+type traceParentExtractor struct{}
+
+func (e *traceParentExtractor) ExtractIDs(req *http.Request) map[string]string {
+	parts := strings.Split(strings.TrimSpace(req.Header.Get("traceparent")), "-")
+	if len(parts) < 4 {
+		return nil
+	}
+	version, traceID, parentID, flags := parts[0], parts[1], parts[2], parts[3]
+	// version "ff" is invalid and only version "00" has exactly 4 fields
+	if len(version) != 2 || !isLowerHex(version) || version == "ff" || (version == "00" && len(parts) != 4) {
+		return nil
+	}
+	if len(traceID) != 32 || !isLowerHex(traceID) || isAllZeros(traceID) {
+		return nil
+	}
+	if len(parentID) != 16 || !isLowerHex(parentID) || isAllZeros(parentID) {
+		return nil
+	}
+	if len(flags) != 2 || !isLowerHex(flags) {
+		return nil
+	}
+	return map[string]string{
+		TraceIDKey: traceID,
+		SpanIDKey:  parentID,
+	}
+}
+
+func isLowerHex(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAllZeros(s string) bool {
+	return strings.Trim(s, "0") == ""
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wlog
+
+import (
+	"io"
+	"os"
+)
+
+// Flusher is implemented by loggers, logger providers and writers that may buffer output. Flush writes any buffered
+// output to its destination. Implementing Flusher is optional: use Flush to flush values that may implement it.
+//
+// Loggers flush the writers that they write to. Logger providers only flush output that they buffer themselves, such
+// as the global buffer of glog: providers do not keep track of the loggers that they create or of their writers, so
+// flushing a provider does not flush the loggers that it created.
+type Flusher interface {
+	Flush() error
+}
+
+// syncer is implemented by writers such as zapcore.WriteSyncer that flush buffered output using Sync.
+type syncer interface {
+	Sync() error
+}
+
+// Flush flushes v if it implements Flusher and does nothing otherwise. Loggers, logger providers and writers should be
+// flushed before the process exits so that buffered output is not lost.
+func Flush(v interface{}) error {
+	if f, ok := v.(Flusher); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// Closer is implemented by loggers and logger providers that should be closed once they are no longer used. Close
+// flushes any buffered output and releases the resources held by the value, such as background goroutines. It does not
+// close the writers that loggers write to, which are owned by the caller. Implementing Closer is optional: use Close to
+// close values that may implement it.
+type Closer interface {
+	Close() error
+}
+
+// Close closes v if it implements Closer and flushes it using Flush otherwise.
+func Close(v interface{}) error {
+	if c, ok := v.(Closer); ok {
+		return c.Close()
+	}
+	return Flush(v)
+}
+
+// FlushWriter flushes w if it buffers output, which is the case if it implements Flusher or has a Sync method. Writes
+// to an *os.File are not buffered by the process, so it is not synced: doing so would commit its content to stable
+// storage, which is slow and fails for terminals and pipes such as os.Stdout.
+func FlushWriter(w io.Writer) error {
+	switch f := w.(type) {
+	case Flusher:
+		return f.Flush()
+	case *os.File:
+		return nil
+	case syncer:
+		return f.Sync()
+	}
+	return nil
+}
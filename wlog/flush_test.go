@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wlog_test
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	bufWriter := bufio.NewWriter(buf)
+	_, _ = bufWriter.WriteString("buffered")
+	require.NoError(t, wlog.FlushWriter(bufWriter))
+	assert.Equal(t, "buffered", buf.String())
+
+	syncer := &testSyncer{}
+	require.NoError(t, wlog.FlushWriter(syncer))
+	assert.Equal(t, 1, syncer.syncs)
+
+	syncer.err = fmt.Errorf("sync failed")
+	assert.EqualError(t, wlog.FlushWriter(syncer), "sync failed")
+
+	// files are not synced, so flushing a terminal or pipe does not fail
+	assert.NoError(t, wlog.FlushWriter(os.Stdout))
+	assert.NoError(t, wlog.FlushWriter(buf))
+}
+
+func TestFlush(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		provider wlog.LoggerProvider
+		want     string
+	}{
+		{
+			name:     "json",
+			provider: wlog.NewJSONMarshalLoggerProvider(),
+			want:     `"message":"hello"`,
+		},
+		{
+			name:     "noop",
+			provider: wlog.NewNoopLoggerProvider(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			bufWriter := bufio.NewWriter(buf)
+			logger := svc1log.NewFromCreator(bufWriter, wlog.InfoLevel, tc.provider.NewLeveledLogger)
+			logger.Info("hello")
+			assert.Empty(t, buf.String())
+
+			require.NoError(t, wlog.Flush(logger))
+			assert.Contains(t, buf.String(), tc.want)
+
+			logger.Info("hello")
+			require.NoError(t, wlog.Close(logger))
+			assert.Equal(t, 0, bufWriter.Buffered())
+
+			// the provider does not buffer output of its own
+			assert.NoError(t, wlog.Flush(tc.provider))
+			assert.NoError(t, wlog.Close(tc.provider))
+		})
+	}
+
+	// values that do not implement wlog.Flusher are not flushed
+	assert.NoError(t, wlog.Flush(struct{}{}))
+	assert.NoError(t, wlog.Flush(nil))
+}
+
+func TestClose(t *testing.T) {
+	closer := &testCloser{}
+	require.NoError(t, wlog.Close(closer))
+	assert.Equal(t, 1, closer.closes)
+	assert.Equal(t, 0, closer.flushes)
+
+	// values that do not implement wlog.Closer are flushed
+	flusher := &testFlusher{}
+	require.NoError(t, wlog.Close(flusher))
+	assert.Equal(t, 1, flusher.flushes)
+	assert.NoError(t, wlog.Close(nil))
+}
+
+type testSyncer struct {
+	bytes.Buffer
+	syncs int
+	err   error
+}
+
+func (s *testSyncer) Sync() error {
+	s.syncs++
+	return s.err
+}
+
+type testFlusher struct {
+	flushes int
+}
+
+func (f *testFlusher) Flush() error {
+	f.flushes++
+	return nil
+}
+
+type testCloser struct {
+	testFlusher
+	closes int
+}
+
+func (c *testCloser) Close() error {
+	c.closes++
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wloginternal
+
+import (
+	"math"
+)
+
+// HistogramQuantile returns an approximation of the q-quantile of the observations of a runtime/metrics histogram with
+// the provided bucket counts and boundaries, where buckets has one more element than counts. The approximation is the
+// upper bound of the bucket that contains the quantile. Because JSON cannot represent infinite values, the lower bound
+// is used for buckets with an infinite upper bound. Returns false if the histogram has no observations.
+func HistogramQuantile(counts []uint64, buckets []float64, q float64) (float64, bool) {
+	var total uint64
+	for _, c := range counts {
+		total += c
+	}
+	if total == 0 || len(buckets) != len(counts)+1 {
+		return 0, false
+	}
+	threshold := uint64(math.Ceil(q * float64(total)))
+	var cumulative uint64
+	for i, c := range counts {
+		cumulative += c
+		if c > 0 && cumulative >= threshold {
+			return bucketBound(buckets[i], buckets[i+1]), true
+		}
+	}
+	return 0, false
+}
+
+func bucketBound(lower, upper float64) float64 {
+	if !math.IsInf(upper, 0) {
+		return upper
+	}
+	if !math.IsInf(lower, 0) {
+		return lower
+	}
+	return 0
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wloginternal_test
+
+import (
+	"math"
+	"testing"
+
+	wloginternal "github.com/palantir/witchcraft-go-logging/wlog/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	buckets := []float64{math.Inf(-1), 1, 2, 4, math.Inf(1)}
+	counts := []uint64{0, 50, 45, 5}
+	for _, tc := range []struct {
+		q    float64
+		want float64
+	}{
+		{q: 0.5, want: 2},
+		{q: 0.95, want: 4},
+		{q: 0.99, want: 4},
+		{q: 1, want: 4},
+	} {
+		got, ok := wloginternal.HistogramQuantile(counts, buckets, tc.q)
+		assert.True(t, ok)
+		assert.Equal(t, tc.want, got, "quantile %v", tc.q)
+	}
+
+	got, ok := wloginternal.HistogramQuantile([]uint64{3, 0}, []float64{math.Inf(-1), 0, 1}, 0.5)
+	assert.True(t, ok)
+	assert.Equal(t, 0.0, got)
+
+	_, ok = wloginternal.HistogramQuantile([]uint64{0, 0}, []float64{0, 1, 2}, 0.5)
+	assert.False(t, ok)
+}
@@ -62,6 +62,10 @@ func (l *jsonMapLogger) Error(msg string, params ...Param) {
 	}
 }
 
+func (l *jsonMapLogger) Flush() error {
+	return FlushWriter(l.w)
+}
+
 func (l *jsonMapLogger) logOutput(params []Param) {
 	params = append(params, StringParam(TimeKey, time.Now().Format(time.RFC3339Nano)))
 
@@ -89,3 +93,13 @@ func (*jsonMarshalLoggerProvider) NewLeveledLogger(w io.Writer, level LogLevel)
 func (p *jsonMarshalLoggerProvider) NewLogEntry() LogEntry {
 	return NewMapLogEntry()
 }
+
+// Flush does nothing: the provider does not keep track of the loggers that it creates, which flush their own writers.
+func (*jsonMarshalLoggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources.
+func (*jsonMarshalLoggerProvider) Close() error {
+	return nil
+}
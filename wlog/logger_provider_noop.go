@@ -33,6 +33,7 @@ func (*nooplogger) Info(msg string, params ...Param)  {}
 func (*nooplogger) Warn(msg string, params ...Param)  {}
 func (*nooplogger) Error(msg string, params ...Param) {}
 func (*nooplogger) SetLevel(level LogLevel)           {}
+func (*nooplogger) Flush() error                      { return nil }
 
 type noopLoggerProvider struct{}
 
@@ -48,6 +49,16 @@ func (p *noopLoggerProvider) NewLogEntry() LogEntry {
 	return &noopLogEntry{}
 }
 
+// Flush does nothing: the provider and the loggers that it creates do not write any output.
+func (*noopLoggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources.
+func (*noopLoggerProvider) Close() error {
+	return nil
+}
+
 type noopLogEntry struct{}
 
 func (*noopLogEntry) StringValue(k, v string)                                         {}
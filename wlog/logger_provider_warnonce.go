@@ -35,6 +35,7 @@ func (l *warnOnceLogger) Info(msg string, params ...Param)  { l.once.Do(l.printW
 func (l *warnOnceLogger) Warn(msg string, params ...Param)  { l.once.Do(l.printWarning) }
 func (l *warnOnceLogger) Error(msg string, params ...Param) { l.once.Do(l.printWarning) }
 func (l *warnOnceLogger) SetLevel(level LogLevel)           { l.once.Do(l.printWarning) }
+func (l *warnOnceLogger) Flush() error                      { return FlushWriter(l.w) }
 
 func (l *warnOnceLogger) printWarning() {
 	_, _ = fmt.Fprintln(l.w, `[WARNING] Logging operation that uses the default logger provider was performed without specifying a logger provider implementation. `+
@@ -59,3 +60,13 @@ func (*warnOnceLoggerProvider) NewLeveledLogger(w io.Writer, level LogLevel) Lev
 func (*warnOnceLoggerProvider) NewLogEntry() LogEntry {
 	return &noopLogEntry{}
 }
+
+// Flush does nothing: the warning is written by the loggers that the provider creates, which flush their own writers.
+func (*warnOnceLoggerProvider) Flush() error {
+	return nil
+}
+
+// Close does nothing: the provider does not hold any resources.
+func (*warnOnceLoggerProvider) Close() error {
+	return nil
+}
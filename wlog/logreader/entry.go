@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+const (
+	typeKey        = "type"
+	timeKey        = "time"
+	payloadKey     = "payload"
+	wrappedLogType = "wrapped.1"
+)
+
+// TypedEntry is a log Entry along with the metadata used to identify and order it.
+type TypedEntry struct {
+	// Source identifies where the entry was read from. For files, this is the path of the file.
+	Source string
+	// Type is the value of the "type" field of the entry, for example "service.1".
+	Type string
+	// Time is the parsed value of the "time" field of the entry. For "wrapped.1" entries, the time of the wrapped
+	// payload is used. Is the zero value if the entry does not have a valid time.
+	Time time.Time
+	// Entry is the decoded JSON object.
+	Entry Entry
+}
+
+// NewTypedEntry returns a TypedEntry for the provided entry.
+func NewTypedEntry(source string, entry Entry) TypedEntry {
+	typ, _ := entry[typeKey].(string)
+	return TypedEntry{
+		Source: source,
+		Type:   typ,
+		Time:   entryTime(typ, entry),
+		Entry:  entry,
+	}
+}
+
+// ParseTypedEntry decodes the provided line, which must be a JSON object, as a TypedEntry.
+func ParseTypedEntry(source string, line []byte) (TypedEntry, error) {
+	entry, err := entryFromLine(line)
+	if err != nil {
+		return TypedEntry{}, err
+	}
+	return NewTypedEntry(source, entry), nil
+}
+
+func entryTime(typ string, entry Entry) time.Time {
+	timeVal := entry[timeKey]
+	if typ == wrappedLogType {
+		if wrapped, ok := wrappedPayload(entry); ok {
+			timeVal = wrapped[timeKey]
+		}
+	}
+	timeStr, ok := timeVal.(string)
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, timeStr)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func entryFromLine(line []byte) (Entry, error) {
+	var entry Entry
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	if err := dec.Decode(&entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
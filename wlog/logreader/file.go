@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// OpenFile opens the file at the provided path for reading. If the content of the file is gzip-compressed, the
+// returned reader decompresses it.
+func OpenFile(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	if magic, err := br.Peek(len(gzipMagic)); err != nil || !bytes.Equal(magic, gzipMagic) {
+		return &fileReader{Reader: br, file: f}, nil
+	}
+	gzr, err := gzip.NewReader(br)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return &fileReader{Reader: gzr, file: f, gzip: gzr}, nil
+}
+
+type fileReader struct {
+	io.Reader
+	file *os.File
+	gzip *gzip.Reader
+}
+
+func (r *fileReader) Close() error {
+	if r.gzip != nil {
+		_ = r.gzip.Close()
+	}
+	return r.file.Close()
+}
+
+// RotatedSegments returns the paths of the rotated segments of the log file at the provided path, ordered from oldest
+// to newest. Rotated segments are files in the same directory whose names start with the base name of the log file
+// followed by "-" and end with the extension of the log file, optionally followed by ".gz". For example, the rotated
+// segments of "var/log/service.log" include "var/log/service-2018-01-01-0.log.gz".
+func RotatedSegments(path string) ([]string, error) {
+	dir := filepath.Dir(path)
+	ext := filepath.Ext(path)
+	prefix := strings.TrimSuffix(filepath.Base(path), ext) + "-"
+
+	infos, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type segment struct {
+		path string
+		info os.FileInfo
+	}
+	var segments []segment
+	for _, dirEntry := range infos {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if !strings.HasSuffix(strings.TrimSuffix(name, ".gz"), ext) {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment{path: filepath.Join(dir, name), info: info})
+	}
+	sort.SliceStable(segments, func(i, j int) bool {
+		if !segments[i].info.ModTime().Equal(segments[j].info.ModTime()) {
+			return segments[i].info.ModTime().Before(segments[j].info.ModTime())
+		}
+		return segments[i].path < segments[j].path
+	})
+	paths := make([]string, len(segments))
+	for i, s := range segments {
+		paths[i] = s.path
+	}
+	return paths, nil
+}
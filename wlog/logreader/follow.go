@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"bufio"
+	"io"
+	"os"
+)
+
+// FollowParam configures a Follower.
+type FollowParam interface {
+	apply(f *Follower)
+}
+
+type followParamFunc func(f *Follower)
+
+func (fn followParamFunc) apply(f *Follower) {
+	fn(f)
+}
+
+// FollowFromEnd configures the Follower to skip the content that is already present in the followed file when it is
+// first opened, similar to "tail -f -n 0".
+func FollowFromEnd() FollowParam {
+	return followParamFunc(func(f *Follower) {
+		f.fromEnd = true
+	})
+}
+
+// FollowRotatedSegments configures the Follower to read all of the rotated segments of the followed file (as returned
+// by RotatedSegments) before reading the file itself. Has no effect if FollowFromEnd is also specified.
+func FollowRotatedSegments() FollowParam {
+	return followParamFunc(func(f *Follower) {
+		f.withRotated = true
+	})
+}
+
+// Follower is an EntryReader that follows a log file in the same manner as "tail -F". Once the end of the file is
+// reached, Next returns ErrPending until more content is appended. If the file is truncated, the Follower starts
+// reading again from its beginning. If the file is rotated by renaming it and creating a new file at the same path,
+// the Follower reads the remaining content of the renamed file and then switches to the new file. A missing file is
+// treated as pending rather than as an error.
+type Follower struct {
+	path        string
+	fromEnd     bool
+	withRotated bool
+
+	segments []string
+	segment  EntryReader
+	closer   io.Closer
+
+	file    *os.File
+	info    os.FileInfo
+	r       *bufio.Reader
+	offset  int64
+	partial []byte
+	rotated bool
+	opened  bool
+}
+
+// NewFollower returns a Follower for the log file at the provided path.
+func NewFollower(path string, params ...FollowParam) (*Follower, error) {
+	f := &Follower{
+		path: path,
+	}
+	for _, p := range params {
+		p.apply(f)
+	}
+	if f.withRotated && !f.fromEnd {
+		segments, err := RotatedSegments(path)
+		if err != nil {
+			return nil, err
+		}
+		f.segments = segments
+	}
+	return f, nil
+}
+
+func (f *Follower) Next() (TypedEntry, error) {
+	if entry, ok, err := f.nextFromSegments(); ok {
+		return entry, err
+	}
+	for {
+		if f.file == nil {
+			if err := f.open(); err != nil {
+				if os.IsNotExist(err) {
+					return TypedEntry{}, ErrPending
+				}
+				return TypedEntry{}, err
+			}
+		}
+
+		line, err := f.r.ReadBytes('\n')
+		f.offset += int64(len(line))
+		if err == nil {
+			if len(f.partial) > 0 {
+				line = append(f.partial, line...)
+				f.partial = nil
+			}
+			entry, ok, decodeErr := decodeLine(f.path, line)
+			if ok || decodeErr != nil {
+				return entry, decodeErr
+			}
+			continue
+		}
+		if err != io.EOF {
+			return TypedEntry{}, err
+		}
+		f.partial = append(f.partial, line...)
+
+		if f.rotated {
+			// the rotated file has been fully read: switch to the new file. Any partial line at the end of the rotated
+			// file is decoded as-is, since nothing more will be written to it.
+			partial := f.partial
+			f.closeFile()
+			if entry, ok, decodeErr := decodeLine(f.path, partial); ok || decodeErr != nil {
+				return entry, decodeErr
+			}
+			continue
+		}
+		if changed, err := f.checkFile(); err != nil {
+			return TypedEntry{}, err
+		} else if !changed {
+			return TypedEntry{}, ErrPending
+		}
+	}
+}
+
+// Close closes any file that is currently open.
+func (f *Follower) Close() error {
+	if f.closer != nil {
+		_ = f.closer.Close()
+		f.closer = nil
+	}
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// nextFromSegments returns the next entry from the rotated segments. Returns false if all of the segments have been
+// read.
+func (f *Follower) nextFromSegments() (TypedEntry, bool, error) {
+	for f.segment != nil || len(f.segments) > 0 {
+		if f.segment == nil {
+			rc, err := OpenFile(f.segments[0])
+			if err != nil {
+				return TypedEntry{}, true, err
+			}
+			f.segment = NewReader(f.segments[0], rc)
+			f.closer = rc
+			f.segments = f.segments[1:]
+		}
+		entry, err := f.segment.Next()
+		if err != io.EOF {
+			return entry, true, err
+		}
+		_ = f.closer.Close()
+		f.segment = nil
+		f.closer = nil
+	}
+	return TypedEntry{}, false, nil
+}
+
+func (f *Follower) open() error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	var offset int64
+	if f.fromEnd && !f.opened {
+		if offset, err = file.Seek(0, io.SeekEnd); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+	f.file = file
+	f.info = info
+	f.r = bufio.NewReader(file)
+	f.offset = offset
+	f.opened = true
+	return nil
+}
+
+func (f *Follower) closeFile() {
+	_ = f.file.Close()
+	f.file = nil
+	f.info = nil
+	f.r = nil
+	f.offset = 0
+	f.partial = nil
+	f.rotated = false
+}
+
+// checkFile checks whether the followed file has been truncated or rotated. Returns true if the state of the
+// Follower changed such that reading should be attempted again.
+func (f *Follower) checkFile() (bool, error) {
+	currInfo, err := f.file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if currInfo.Size() < f.offset {
+		// file was truncated: start reading again from the beginning
+		if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		f.r.Reset(f.file)
+		f.offset = 0
+		f.partial = nil
+		return true, nil
+	}
+	pathInfo, err := os.Stat(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// file was renamed but the new file has not been created yet
+			return false, nil
+		}
+		return false, err
+	}
+	if !os.SameFile(f.info, pathInfo) {
+		// file was rotated: read any content written to the old file before switching to the new one
+		f.rotated = true
+		return true, nil
+	}
+	return false, nil
+}
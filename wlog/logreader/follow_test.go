@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader_test
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollower(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "service.log")
+
+	follower, err := logreader.NewFollower(path)
+	require.NoError(t, err)
+	defer func() {
+		_ = follower.Close()
+	}()
+
+	// missing file is pending
+	_, err = follower.Next()
+	assert.Equal(t, logreader.ErrPending, err)
+
+	appendLines(t, path, logLine("service.1", 1))
+	assertMessages(t, follower, "1")
+
+	// partial lines are not returned until complete
+	appendRaw(t, path, `{"type":"service.1","message":`)
+	_, err = follower.Next()
+	assert.Equal(t, logreader.ErrPending, err)
+	appendRaw(t, path, `"2"}`+"\n")
+	assertMessages(t, follower, "2")
+
+	// truncation restarts from the beginning of the file
+	require.NoError(t, os.Truncate(path, 0))
+	appendLines(t, path, logLine("service.1", 3))
+	assertMessages(t, follower, "3")
+
+	// rename rotation reads the remainder of the old file before switching to the new one
+	appendLines(t, path, logLine("service.1", 4))
+	require.NoError(t, os.Rename(path, filepath.Join(dir, "service-1.log")))
+	appendLines(t, filepath.Join(dir, "service-1.log"), logLine("service.1", 5))
+	appendLines(t, path, logLine("service.1", 6))
+	assertMessages(t, follower, "4", "5", "6")
+}
+
+func TestFollowerRotatedSegments(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "service.log")
+
+	writeGzip(t, filepath.Join(dir, "service-2018-01-01-0.log.gz"), logLine("service.1", 1))
+	setModTime(t, filepath.Join(dir, "service-2018-01-01-0.log.gz"), time.Now().Add(-2*time.Hour))
+	appendLines(t, filepath.Join(dir, "service-2018-01-01-1.log"), logLine("service.1", 2))
+	setModTime(t, filepath.Join(dir, "service-2018-01-01-1.log"), time.Now().Add(-time.Hour))
+	appendLines(t, filepath.Join(dir, "request.log"), logLine("request.2", 100))
+	appendLines(t, path, logLine("service.1", 3))
+
+	follower, err := logreader.NewFollower(path, logreader.FollowRotatedSegments())
+	require.NoError(t, err)
+	defer func() {
+		_ = follower.Close()
+	}()
+	assertMessages(t, follower, "1", "2", "3")
+}
+
+func TestFollowerFromEnd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "service.log")
+	appendLines(t, path, logLine("service.1", 1))
+
+	follower, err := logreader.NewFollower(path, logreader.FollowFromEnd())
+	require.NoError(t, err)
+	defer func() {
+		_ = follower.Close()
+	}()
+
+	_, err = follower.Next()
+	assert.Equal(t, logreader.ErrPending, err)
+	appendLines(t, path, logLine("service.1", 2))
+	assertMessages(t, follower, "2")
+}
+
+func TestEntriesFromFileGzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "service-1.log.gz")
+	writeGzip(t, path, logLine("service.1", 1), logLine("service.1", 2))
+
+	entries, err := logreader.EntriesFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "2", entries[1]["message"])
+}
+
+func logLine(typ string, i int) string {
+	return fmt.Sprintf(`{"type":%q,"time":%q,"message":"%d"}`, typ, time.Unix(int64(i), 0).UTC().Format(time.RFC3339Nano), i)
+}
+
+func appendLines(t *testing.T, path string, lines ...string) {
+	for _, line := range lines {
+		appendRaw(t, path, line+"\n")
+	}
+}
+
+func appendRaw(t *testing.T, path string, content string) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func writeGzip(t *testing.T, path string, lines ...string) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := gzip.NewWriter(f)
+	for _, line := range lines {
+		_, err := io.WriteString(w, line+"\n")
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+}
+
+func setModTime(t *testing.T, path string, modTime time.Time) {
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func assertMessages(t *testing.T, r logreader.EntryReader, want ...string) {
+	for _, msg := range want {
+		entry, err := r.Next()
+		require.NoError(t, err)
+		assert.Equal(t, msg, entry.Entry["message"])
+	}
+	_, err := r.Next()
+	assert.Contains(t, []error{logreader.ErrPending, io.EOF}, err)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logconv
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+)
+
+const unsafeParamsKey = "unsafeParams"
+
+// leadingFields are the fields that are output first (in this order) when no fields are selected.
+var leadingFields = []string{"type", "time", "level"}
+
+// Flatten returns the fields of the provided entry as a flat map. The keys of nested objects are joined to the key of
+// their parent using ".", so {"params":{"tenant":"acme"}} is flattened to {"params.tenant":"acme"}. Arrays are not
+// flattened. If unwrap is true and the entry is a wrapped.1 entry, the fields of the wrapped payload are returned
+// along with the "entityName" and "entityVersion" fields of the wrapper.
+func Flatten(entry logreader.TypedEntry, unwrap bool) map[string]interface{} {
+	fields := make(map[string]interface{})
+	m := map[string]interface{}(entry.Entry)
+	if unwrap {
+		if payload, ok := logreader.WrappedPayload(entry); ok {
+			for _, k := range []string{"entityName", "entityVersion"} {
+				if v, ok := entry.Entry[k]; ok {
+					fields[k] = v
+				}
+			}
+			m = payload
+		}
+	}
+	flattenInto(fields, "", m)
+	return fields
+}
+
+func flattenInto(fields map[string]interface{}, prefix string, m map[string]interface{}) {
+	for k, v := range m {
+		if nested, ok := v.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenInto(fields, prefix+k+".", nested)
+			continue
+		}
+		fields[prefix+k] = v
+	}
+}
+
+// fieldSelector selects and orders the keys of flattened entries.
+type fieldSelector struct {
+	patterns            []string
+	includeUnsafeParams bool
+}
+
+// hasWildcards returns true if the set of keys selected by the selector depends on the entries.
+func (s fieldSelector) hasWildcards() bool {
+	if len(s.patterns) == 0 {
+		return true
+	}
+	for _, p := range s.patterns {
+		if strings.HasSuffix(p, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// keys returns the keys of the provided fields that are selected, in output order.
+func (s fieldSelector) keys(fields map[string]interface{}) []string {
+	var allKeys []string
+	for k := range fields {
+		if !s.allowed(k) {
+			continue
+		}
+		allKeys = append(allKeys, k)
+	}
+	sort.Strings(allKeys)
+
+	if len(s.patterns) == 0 {
+		return orderKeys(allKeys)
+	}
+	var keys []string
+	seen := make(map[string]struct{})
+	for _, p := range s.patterns {
+		for _, k := range allKeys {
+			if _, ok := seen[k]; ok || !matchesPattern(p, k) {
+				continue
+			}
+			seen[k] = struct{}{}
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// allowed returns false if the key must be omitted regardless of the patterns.
+func (s fieldSelector) allowed(key string) bool {
+	return s.includeUnsafeParams || (key != unsafeParamsKey && !strings.HasPrefix(key, unsafeParamsKey+"."))
+}
+
+// orderKeys returns the provided sorted keys with the leading fields moved to the front.
+func orderKeys(sortedKeys []string) []string {
+	keys := make([]string, 0, len(sortedKeys))
+	leading := make(map[string]struct{}, len(leadingFields))
+	for _, lf := range leadingFields {
+		leading[lf] = struct{}{}
+		for _, k := range sortedKeys {
+			if k == lf {
+				keys = append(keys, k)
+			}
+		}
+	}
+	for _, k := range sortedKeys {
+		if _, ok := leading[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// matchesPattern returns true if the key matches the pattern. A pattern matches a key if it is equal to the key or if
+// the pattern ends in "*" and the key starts with the part of the pattern before the "*" ("params.*" matches
+// "params.tenant" and "*" matches every key).
+func matchesPattern(pattern, key string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(key, strings.TrimSuffix(pattern, "*"))
+	}
+	return key == pattern
+}
+
+// formatValue returns the string representation of a value. Strings and numbers are returned as-is, null is returned
+// as the empty string and arrays and objects are returned as JSON.
+func formatValue(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case map[string]interface{}, []interface{}:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(val)
+}
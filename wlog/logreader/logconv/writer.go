@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logconv
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+)
+
+// Format is an output format for converted entries.
+type Format string
+
+const (
+	// FormatLogfmt writes every entry as a line of space-separated key=value pairs.
+	FormatLogfmt Format = "logfmt"
+	// FormatCSV writes entries as CSV with a header row.
+	FormatCSV Format = "csv"
+	// FormatNDJSON writes every entry as a JSON object on its own line whose keys are the flattened field names.
+	FormatNDJSON Format = "ndjson"
+)
+
+// Config configures the conversion of entries.
+type Config struct {
+	// Fields are the flattened field names to output, in order. A field that ends in "*" selects all of the fields
+	// that start with the part before the "*" in sorted order: for example, "params.*" selects every safe param. If
+	// empty, all fields are output with "type", "time" and "level" first and the others in sorted order.
+	Fields []string
+	// IncludeUnsafeParams includes the "unsafeParams" fields in the output. If false, they are omitted even if they
+	// are selected by Fields.
+	IncludeUnsafeParams bool
+	// KeepWrapped outputs wrapped.1 entries as-is rather than outputting the fields of the wrapped payload.
+	KeepWrapped bool
+}
+
+// Writer writes converted entries.
+type Writer interface {
+	// Write writes the provided entry.
+	Write(entry logreader.TypedEntry) error
+	// Close writes any buffered output. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter returns a Writer that writes entries to w in the provided format.
+func NewWriter(w io.Writer, format Format, cfg Config) (Writer, error) {
+	base := baseWriter{
+		selector: fieldSelector{
+			patterns:            cfg.Fields,
+			includeUnsafeParams: cfg.IncludeUnsafeParams,
+		},
+		unwrap: !cfg.KeepWrapped,
+	}
+	switch format {
+	case FormatLogfmt:
+		return &logfmtWriter{baseWriter: base, w: bufio.NewWriter(w)}, nil
+	case FormatCSV:
+		return &csvWriter{baseWriter: base, w: csv.NewWriter(w)}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{baseWriter: base, w: bufio.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q: must be one of %q, %q or %q", format, FormatLogfmt, FormatCSV, FormatNDJSON)
+}
+
+type baseWriter struct {
+	selector fieldSelector
+	unwrap   bool
+}
+
+func (b baseWriter) fields(entry logreader.TypedEntry) (map[string]interface{}, []string) {
+	fields := Flatten(entry, b.unwrap)
+	return fields, b.selector.keys(fields)
+}
+
+type logfmtWriter struct {
+	baseWriter
+	w *bufio.Writer
+}
+
+func (l *logfmtWriter) Write(entry logreader.TypedEntry) error {
+	fields, keys := l.fields(entry)
+	for i, k := range keys {
+		if i > 0 {
+			_ = l.w.WriteByte(' ')
+		}
+		_, _ = l.w.WriteString(logfmtKey(k))
+		_ = l.w.WriteByte('=')
+		_, _ = l.w.WriteString(logfmtValue(formatValue(fields[k])))
+	}
+	_ = l.w.WriteByte('\n')
+	return l.w.Flush()
+}
+
+func (l *logfmtWriter) Close() error {
+	return l.w.Flush()
+}
+
+// logfmtKey returns the provided key with any characters that are not valid in a logfmt key replaced with "_".
+func logfmtKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' || r == unicode.ReplacementChar {
+			return '_'
+		}
+		return r
+	}, key)
+}
+
+// logfmtValue returns the provided value quoted if it is empty or contains whitespace, '=', '"', '\' or control
+// characters.
+func logfmtValue(val string) string {
+	if val == "" {
+		return `""`
+	}
+	if strings.IndexFunc(val, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == '\\' || !unicode.IsPrint(r)
+	}) == -1 {
+		return val
+	}
+	return strconv.Quote(val)
+}
+
+type csvWriter struct {
+	baseWriter
+	w *csv.Writer
+
+	header   []string
+	buffered []map[string]interface{}
+}
+
+// Write writes the entry. If the columns depend on the entries (because no fields or fields with wildcards were
+// selected), the entries are buffered until Close is called so that the header contains every column.
+func (c *csvWriter) Write(entry logreader.TypedEntry) error {
+	fields := Flatten(entry, c.unwrap)
+	if c.selector.hasWildcards() {
+		c.buffered = append(c.buffered, fields)
+		return nil
+	}
+	if c.header == nil {
+		c.header = []string{}
+		for _, k := range c.selector.patterns {
+			if c.selector.allowed(k) {
+				c.header = append(c.header, k)
+			}
+		}
+		if err := c.w.Write(c.header); err != nil {
+			return err
+		}
+	}
+	return c.writeRecord(fields)
+}
+
+func (c *csvWriter) Close() error {
+	if c.buffered != nil {
+		allFields := make(map[string]interface{})
+		for _, fields := range c.buffered {
+			for k := range fields {
+				allFields[k] = nil
+			}
+		}
+		c.header = c.selector.keys(allFields)
+		if err := c.w.Write(c.header); err != nil {
+			return err
+		}
+		for _, fields := range c.buffered {
+			if err := c.writeRecord(fields); err != nil {
+				return err
+			}
+		}
+		c.buffered = nil
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) writeRecord(fields map[string]interface{}) error {
+	record := make([]string, len(c.header))
+	for i, k := range c.header {
+		record[i] = formatValue(fields[k])
+	}
+	return c.w.Write(record)
+}
+
+type ndjsonWriter struct {
+	baseWriter
+	w *bufio.Writer
+}
+
+func (n *ndjsonWriter) Write(entry logreader.TypedEntry) error {
+	fields, keys := n.fields(entry)
+	_ = n.w.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			_ = n.w.WriteByte(',')
+		}
+		keyJSON, err := json.Marshal(k)
+		if err != nil {
+			return err
+		}
+		valJSON, err := json.Marshal(fields[k])
+		if err != nil {
+			return err
+		}
+		_, _ = n.w.Write(keyJSON)
+		_ = n.w.WriteByte(':')
+		_, _ = n.w.Write(valJSON)
+	}
+	_, _ = n.w.WriteString("}\n")
+	return n.w.Flush()
+}
+
+func (n *ndjsonWriter) Close() error {
+	return n.w.Flush()
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logconv_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader/logconv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testInput = `{"type":"service.1","time":"2018-01-01T10:00:00Z","level":"INFO","message":"hello world","origin":"foo.go:10","params":{"tenant":"acme","count":3},"unsafeParams":{"user":"jdoe"}}
+{"type":"wrapped.1","entityName":"my-service","entityVersion":"1.0.0","payload":{"type":"serviceLogV1","serviceLogV1":{"type":"service.1","time":"2018-01-01T10:00:01Z","level":"WARN","message":"quote \"this\"","params":{"tenant":"other","ids":["a","b"]}}}}
+`
+
+func TestWriter(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		format logconv.Format
+		cfg    logconv.Config
+		want   string
+	}{
+		{
+			name:   "logfmt with all fields",
+			format: logconv.FormatLogfmt,
+			want: `type=service.1 time=2018-01-01T10:00:00Z level=INFO message="hello world" origin=foo.go:10 params.count=3 params.tenant=acme
+type=service.1 time=2018-01-01T10:00:01Z level=WARN entityName=my-service entityVersion=1.0.0 message="quote \"this\"" params.ids="[\"a\",\"b\"]" params.tenant=other
+`,
+		},
+		{
+			name:   "logfmt with unsafe params",
+			format: logconv.FormatLogfmt,
+			cfg:    logconv.Config{Fields: []string{"level", "unsafeParams.*"}, IncludeUnsafeParams: true},
+			want: `level=INFO unsafeParams.user=jdoe
+level=WARN
+`,
+		},
+		{
+			name:   "logfmt keeping wrapped entries",
+			format: logconv.FormatLogfmt,
+			cfg:    logconv.Config{Fields: []string{"type", "payload.type"}, KeepWrapped: true},
+			want: `type=service.1
+type=wrapped.1 payload.type=serviceLogV1
+`,
+		},
+		{
+			name:   "CSV with fixed fields",
+			format: logconv.FormatCSV,
+			cfg:    logconv.Config{Fields: []string{"time", "message", "params.tenant", "unsafeParams.user"}},
+			want: `time,message,params.tenant
+2018-01-01T10:00:00Z,hello world,acme
+2018-01-01T10:00:01Z,"quote ""this""",other
+`,
+		},
+		{
+			name:   "CSV with wildcard fields",
+			format: logconv.FormatCSV,
+			cfg:    logconv.Config{Fields: []string{"level", "params.*"}},
+			want: `level,params.count,params.ids,params.tenant
+INFO,3,,acme
+WARN,,"[""a"",""b""]",other
+`,
+		},
+		{
+			name:   "NDJSON",
+			format: logconv.FormatNDJSON,
+			cfg:    logconv.Config{Fields: []string{"level", "params.*"}},
+			want: `{"level":"INFO","params.count":3,"params.tenant":"acme"}
+{"level":"WARN","params.ids":["a","b"],"params.tenant":"other"}
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := logconv.NewWriter(buf, tc.format, tc.cfg)
+			require.NoError(t, err)
+
+			r := logreader.NewReader("test", strings.NewReader(testInput))
+			for {
+				entry, err := r.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				require.NoError(t, w.Write(entry))
+			}
+			require.NoError(t, w.Close())
+			assert.Equal(t, tc.want, buf.String())
+		})
+	}
+}
+
+func TestNewWriterInvalidFormat(t *testing.T) {
+	_, err := logconv.NewWriter(&bytes.Buffer{}, "xml", logconv.Config{})
+	assert.EqualError(t, err, `unsupported format "xml": must be one of "logfmt", "csv" or "ndjson"`)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"io"
+	"time"
+)
+
+// DefaultMergeWindow is the default amount of time that a Merger waits for pending readers before emitting an entry.
+const DefaultMergeWindow = time.Second
+
+// MergeParam configures a Merger.
+type MergeParam interface {
+	apply(m *Merger)
+}
+
+type mergeParamFunc func(m *Merger)
+
+func (fn mergeParamFunc) apply(m *Merger) {
+	fn(m)
+}
+
+// MergeWindow sets the amount of time that a Merger waits for readers that are pending before emitting the oldest
+// entry that is available from the other readers. A larger window tolerates more delay between writers at the cost of
+// latency.
+func MergeWindow(window time.Duration) MergeParam {
+	return mergeParamFunc(func(m *Merger) {
+		m.window = window
+	})
+}
+
+// Merger is an EntryReader that merges the entries of multiple readers into a single stream ordered by time. Assuming
+// that the entries of each reader are ordered by time, the merged stream is also ordered by time. Entries with the
+// same time are ordered by the position of their reader.
+//
+// If some readers are pending (as is the case for a Follower that has reached the end of its file), the Merger cannot
+// know whether those readers will still produce entries that are older than the ones that are available. In that case,
+// it returns ErrPending until the oldest available entry has been held back for the configured merge window, after
+// which it emits that entry. Every entry is held back for up to the merge window from the time it was read, so entries
+// that are written by the pending readers within the window are still emitted in order.
+type Merger struct {
+	readers []EntryReader
+	heads   []*TypedEntry
+	// readAt records the time at which each head was read.
+	readAt []time.Time
+	done   []bool
+	window time.Duration
+	now    func() time.Time
+}
+
+// NewMerger returns a Merger for the provided readers.
+func NewMerger(readers []EntryReader, params ...MergeParam) *Merger {
+	m := &Merger{
+		readers: readers,
+		heads:   make([]*TypedEntry, len(readers)),
+		readAt:  make([]time.Time, len(readers)),
+		done:    make([]bool, len(readers)),
+		window:  DefaultMergeWindow,
+		now:     time.Now,
+	}
+	for _, p := range params {
+		p.apply(m)
+	}
+	return m
+}
+
+func (m *Merger) Next() (TypedEntry, error) {
+	pending := false
+	for i, r := range m.readers {
+		if m.done[i] || m.heads[i] != nil {
+			continue
+		}
+		entry, err := r.Next()
+		switch err {
+		case nil:
+			m.heads[i] = &entry
+			m.readAt[i] = m.now()
+		case io.EOF:
+			m.done[i] = true
+		case ErrPending:
+			pending = true
+		default:
+			return TypedEntry{}, err
+		}
+	}
+
+	oldest := -1
+	for i, head := range m.heads {
+		if head != nil && (oldest == -1 || head.Time.Before(m.heads[oldest].Time)) {
+			oldest = i
+		}
+	}
+	if oldest == -1 {
+		if pending {
+			return TypedEntry{}, ErrPending
+		}
+		return TypedEntry{}, io.EOF
+	}
+	if pending && m.now().Sub(m.readAt[oldest]) < m.window {
+		return TypedEntry{}, ErrPending
+	}
+	entry := *m.heads[oldest]
+	m.heads[oldest] = nil
+	return entry, nil
+}
+
+// Close closes all of the readers that implement io.Closer and returns the first error that occurred.
+func (m *Merger) Close() error {
+	var firstErr error
+	for _, r := range m.readers {
+		if c, ok := r.(io.Closer); ok {
+			if err := c.Close(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergerWindowElapsedRepeatedly(t *testing.T) {
+	now := time.Unix(0, 0)
+	service, request := &pendingReader{}, &pendingReader{}
+	merger := NewMerger([]EntryReader{service, request}, MergeWindow(time.Minute))
+	merger.now = func() time.Time {
+		return now
+	}
+
+	var got []int64
+	next := func() error {
+		entry, err := merger.Next()
+		if err == nil {
+			got = append(got, entry.Time.Unix())
+		}
+		return err
+	}
+
+	// the window elapses while request is pending, so the service entry is emitted
+	service.add(1)
+	assert.Equal(t, ErrPending, next())
+	now = now.Add(time.Minute)
+	require.NoError(t, next())
+
+	// entries read after the window elapsed are held back for a window of their own
+	now = now.Add(time.Hour)
+	service.add(3)
+	assert.Equal(t, ErrPending, next())
+	now = now.Add(time.Second)
+	request.add(2)
+	require.NoError(t, next())
+	assert.Equal(t, ErrPending, next())
+
+	// the window elapses again
+	now = now.Add(time.Minute)
+	require.NoError(t, next())
+	service.add(5)
+	assert.Equal(t, ErrPending, next())
+	request.add(4)
+	require.NoError(t, next())
+	now = now.Add(time.Minute)
+	require.NoError(t, next())
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, got)
+}
+
+// pendingReader is an EntryReader that returns ErrPending when it does not have any entries.
+type pendingReader struct {
+	entries []TypedEntry
+}
+
+func (r *pendingReader) add(sec int64) {
+	r.entries = append(r.entries, TypedEntry{Time: time.Unix(sec, 0)})
+}
+
+func (r *pendingReader) Next() (TypedEntry, error) {
+	if len(r.entries) == 0 {
+		return TypedEntry{}, ErrPending
+	}
+	entry := r.entries[0]
+	r.entries = r.entries[1:]
+	return entry, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader_test
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerger(t *testing.T) {
+	merger := logreader.NewMerger([]logreader.EntryReader{
+		logreader.NewReader("service.log", strings.NewReader(lines(logLine("service.1", 1), logLine("service.1", 4), logLine("service.1", 5)))),
+		logreader.NewReader("request.log", strings.NewReader(lines(logLine("request.2", 2), logLine("request.2", 5)))),
+		logreader.NewReader("trace.log", strings.NewReader(lines(logLine("trace.1", 3), "", logLine("trace.1", 6)))),
+	})
+
+	var got []string
+	for {
+		entry, err := merger.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, entry.Type+":"+entry.Entry["message"].(string))
+	}
+	assert.Equal(t, []string{
+		"service.1:1",
+		"request.2:2",
+		"trace.1:3",
+		"service.1:4",
+		"service.1:5",
+		"request.2:5",
+		"trace.1:6",
+	}, got)
+}
+
+func TestMergerWaitsForPendingReaders(t *testing.T) {
+	dir := t.TempDir()
+	servicePath := filepath.Join(dir, "service.log")
+	requestPath := filepath.Join(dir, "request.log")
+	appendLines(t, servicePath, logLine("service.1", 2))
+
+	serviceFollower, err := logreader.NewFollower(servicePath)
+	require.NoError(t, err)
+	requestFollower, err := logreader.NewFollower(requestPath)
+	require.NoError(t, err)
+	merger := logreader.NewMerger([]logreader.EntryReader{serviceFollower, requestFollower}, logreader.MergeWindow(time.Hour))
+	defer func() {
+		_ = merger.Close()
+	}()
+
+	// request.log is pending, so the service entry is held back
+	_, err = merger.Next()
+	assert.Equal(t, logreader.ErrPending, err)
+
+	appendLines(t, requestPath, logLine("request.2", 1))
+	entry, err := merger.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "request.2", entry.Type)
+	assert.Equal(t, "request.log", filepath.Base(entry.Source))
+
+	appendLines(t, requestPath, logLine("request.2", 3))
+	entry, err = merger.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "service.1", entry.Type)
+	assert.Equal(t, time.Unix(2, 0).UTC(), entry.Time)
+}
+
+func TestMergerWindowElapsed(t *testing.T) {
+	dir := t.TempDir()
+	servicePath := filepath.Join(dir, "service.log")
+	appendLines(t, servicePath, logLine("service.1", 1))
+
+	serviceFollower, err := logreader.NewFollower(servicePath)
+	require.NoError(t, err)
+	requestFollower, err := logreader.NewFollower(filepath.Join(dir, "request.log"))
+	require.NoError(t, err)
+	merger := logreader.NewMerger([]logreader.EntryReader{serviceFollower, requestFollower}, logreader.MergeWindow(0))
+	defer func() {
+		_ = merger.Close()
+	}()
+
+	entry, err := merger.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "service.1", entry.Type)
+	_, err = merger.Next()
+	assert.Equal(t, logreader.ErrPending, err)
+}
+
+func TestTypedEntryWrappedTime(t *testing.T) {
+	r := logreader.NewReader("", strings.NewReader(`{"type":"wrapped.1","payload":{"type":"serviceLogV1","serviceLogV1":{"type":"service.1","time":"2018-01-01T00:00:00Z"}}}`))
+	entry, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "wrapped.1", entry.Type)
+	assert.Equal(t, time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), entry.Time)
+}
+
+func lines(lines ...string) string {
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Query is a compiled query expression that matches log entries.
+//
+// A query is composed of comparisons combined using "&&", "||", "!" and parentheses. A comparison has the form
+// "<field> <op> <value>", where <field> is a dot-separated path into the entry, <op> is one of "==", "!=", "<", "<=",
+// ">", ">=", "=~" (matches regular expression) or "!~" (does not match regular expression) and <value> is a
+// double-quoted string, a number, true, false, null or a bare word (which is treated as a string). A field on its own
+// matches entries in which the field is present and is not null or false. For example:
+//
+//	level >= WARN && params.tenant == "acme" && time > "2018-01-01T10:00:00Z" && time < "2018-01-01T10:05:00Z"
+//	type == "request.2" && (status >= 500 || path =~ "^/api/v2/")
+//	origin =~ "^com\\.palantir\\." && !unsafeParams.userId
+//
+// Path segments that are not identifiers can be quoted: params."my.key". Fields of "wrapped.1" entries are resolved
+// against the wrapped payload first and then against the top-level entry, so "type == \"service.1\"" matches both
+// service.1 entries and wrapped.1 entries that wrap a service.1 entry.
+//
+// Ordered comparisons of "level" fields use the severity of the level (TRACE < DEBUG < INFO < WARN < ERROR < FATAL).
+// Values that are both valid RFC3339 timestamps are compared as times, numbers are compared numerically and all other
+// values are compared as strings. A comparison of a field that is absent from the entry is false, except for "!=" and
+// "!~" which are true.
+type Query struct {
+	query string
+	expr  queryExpr
+}
+
+// ParseQuery compiles the provided query expression. Returns an error if the query is not valid.
+func ParseQuery(query string) (*Query, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d in query %q", tok, tok.pos, query)
+	}
+	return &Query{
+		query: query,
+		expr:  expr,
+	}, nil
+}
+
+// Matches returns true if the provided entry matches the query.
+func (q *Query) Matches(entry TypedEntry) bool {
+	return q.expr.eval(entry)
+}
+
+func (q *Query) String() string {
+	return q.query
+}
+
+// Filter returns an EntryReader that returns only the entries of r for which match returns true. Errors returned by
+// r are returned as-is. A *Query can be used as a filter by providing its Matches function.
+func Filter(r EntryReader, match func(TypedEntry) bool) EntryReader {
+	return &filterReader{
+		r:     r,
+		match: match,
+	}
+}
+
+type filterReader struct {
+	r     EntryReader
+	match func(TypedEntry) bool
+}
+
+func (f *filterReader) Next() (TypedEntry, error) {
+	for {
+		entry, err := f.r.Next()
+		if err != nil || f.match(entry) {
+			return entry, err
+		}
+	}
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *queryParser) parseOr() (queryExpr, error) {
+	lhs, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		rhs, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &orExpr{lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *queryParser) parseAnd() (queryExpr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &andExpr{lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *queryParser) parseUnary() (queryExpr, error) {
+	switch tok := p.peek(); tok.kind {
+	case tokenNot:
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	case tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, fmt.Errorf("expected ) at position %d but found %s", closing.pos, closing)
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *queryParser) parseComparison() (queryExpr, error) {
+	path, err := p.parsePath()
+	if err != nil {
+		return nil, err
+	}
+	opTok := p.peek()
+	if opTok.kind != tokenOp {
+		return &existsExpr{path: path}, nil
+	}
+	p.next()
+
+	valTok := p.next()
+	var val interface{}
+	switch valTok.kind {
+	case tokenString:
+		val = valTok.text
+	case tokenNumber:
+		val = valTok.num
+	case tokenIdent:
+		switch valTok.text {
+		case "true":
+			val = true
+		case "false":
+			val = false
+		case "null":
+			val = nil
+		default:
+			val = valTok.text
+		}
+	default:
+		return nil, fmt.Errorf("expected value at position %d but found %s", valTok.pos, valTok)
+	}
+
+	cmp := &comparisonExpr{
+		path: path,
+		op:   opTok.text,
+		val:  val,
+	}
+	if cmp.op == "=~" || cmp.op == "!~" {
+		str, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("operator %s at position %d requires a string regular expression", cmp.op, opTok.pos)
+		}
+		if cmp.regex, err = regexp.Compile(str); err != nil {
+			return nil, fmt.Errorf("invalid regular expression at position %d: %v", valTok.pos, err)
+		}
+	}
+	return cmp, nil
+}
+
+func (p *queryParser) parsePath() ([]string, error) {
+	tok := p.next()
+	if tok.kind != tokenIdent && tok.kind != tokenString {
+		return nil, fmt.Errorf("expected field at position %d but found %s", tok.pos, tok)
+	}
+	path := []string{tok.text}
+	for p.peek().kind == tokenDot {
+		p.next()
+		tok := p.next()
+		if tok.kind != tokenIdent && tok.kind != tokenString {
+			return nil, fmt.Errorf("expected field name at position %d but found %s", tok.pos, tok)
+		}
+		path = append(path, tok.text)
+	}
+	return path, nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type queryExpr interface {
+	eval(entry TypedEntry) bool
+}
+
+type andExpr struct {
+	lhs, rhs queryExpr
+}
+
+func (e *andExpr) eval(entry TypedEntry) bool {
+	return e.lhs.eval(entry) && e.rhs.eval(entry)
+}
+
+type orExpr struct {
+	lhs, rhs queryExpr
+}
+
+func (e *orExpr) eval(entry TypedEntry) bool {
+	return e.lhs.eval(entry) || e.rhs.eval(entry)
+}
+
+type notExpr struct {
+	expr queryExpr
+}
+
+func (e *notExpr) eval(entry TypedEntry) bool {
+	return !e.expr.eval(entry)
+}
+
+type existsExpr struct {
+	path []string
+}
+
+func (e *existsExpr) eval(entry TypedEntry) bool {
+	val, ok := LookupField(entry, e.path...)
+	if !ok || val == nil {
+		return false
+	}
+	if b, isBool := val.(bool); isBool {
+		return b
+	}
+	return true
+}
+
+type comparisonExpr struct {
+	path  []string
+	op    string
+	val   interface{}
+	regex *regexp.Regexp
+}
+
+func (e *comparisonExpr) eval(entry TypedEntry) bool {
+	val, ok := LookupField(entry, e.path...)
+	if !ok {
+		return e.op == "!=" || e.op == "!~"
+	}
+	switch e.op {
+	case "=~":
+		return val != nil && e.regex.MatchString(queryValueString(val))
+	case "!~":
+		return val == nil || !e.regex.MatchString(queryValueString(val))
+	}
+	if val == nil || e.val == nil {
+		switch e.op {
+		case "==":
+			return val == nil && e.val == nil
+		case "!=":
+			return val != nil || e.val != nil
+		}
+		return false
+	}
+	cmp, ok := e.compare(val)
+	if !ok {
+		return e.op == "!="
+	}
+	switch e.op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	}
+	return false
+}
+
+// compare compares the provided entry value with the value of the expression. Returns false if the values cannot be
+// compared.
+func (e *comparisonExpr) compare(val interface{}) (int, bool) {
+	if b, ok := e.val.(bool); ok {
+		vb, ok := val.(bool)
+		if !ok {
+			return 0, false
+		}
+		if vb == b {
+			return 0, true
+		}
+		return 1, true
+	}
+	if num, ok := e.val.(float64); ok {
+		vnum, ok := queryValueNumber(val)
+		if !ok {
+			return 0, false
+		}
+		return compareFloats(vnum, num), true
+	}
+
+	str := e.val.(string)
+	vstr := queryValueString(val)
+	if e.path[len(e.path)-1] == "level" {
+		if lhs, ok := levelRanks[strings.ToUpper(vstr)]; ok {
+			if rhs, ok := levelRanks[strings.ToUpper(str)]; ok {
+				return lhs - rhs, true
+			}
+		}
+	}
+	if lhs, err := time.Parse(time.RFC3339Nano, vstr); err == nil {
+		if rhs, err := time.Parse(time.RFC3339Nano, str); err == nil {
+			switch {
+			case lhs.Before(rhs):
+				return -1, true
+			case lhs.After(rhs):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return strings.Compare(vstr, str), true
+}
+
+var levelRanks = map[string]int{
+	"TRACE": 0,
+	"DEBUG": 1,
+	"INFO":  2,
+	"WARN":  3,
+	"ERROR": 4,
+	"FATAL": 5,
+}
+
+func compareFloats(lhs, rhs float64) int {
+	switch {
+	case lhs < rhs:
+		return -1
+	case lhs > rhs:
+		return 1
+	}
+	return 0
+}
+
+func queryValueNumber(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func queryValueString(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case map[string]interface{}, []interface{}:
+		if b, err := json.Marshal(v); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprint(val)
+}
+
+// LookupField returns the value at the provided path in the entry and true if it exists. For "wrapped.1" entries, the
+// path is resolved against the wrapped payload first and then against the top-level entry.
+func LookupField(entry TypedEntry, path ...string) (interface{}, bool) {
+	if payload, ok := WrappedPayload(entry); ok {
+		if val, ok := lookupPath(payload, path); ok {
+			return val, true
+		}
+	}
+	return lookupPath(entry.Entry, path)
+}
+
+func lookupPath(m map[string]interface{}, path []string) (interface{}, bool) {
+	var curr interface{} = m
+	for _, key := range path {
+		var currMap map[string]interface{}
+		switch v := curr.(type) {
+		case map[string]interface{}:
+			currMap = v
+		case Entry:
+			currMap = v
+		default:
+			return nil, false
+		}
+		val, ok := currMap[key]
+		if !ok {
+			return nil, false
+		}
+		curr = val
+	}
+	return curr, true
+}
+
+// WrappedPayload returns the entry wrapped by the provided entry and true if the provided entry is a "wrapped.1" entry
+// with a valid payload.
+func WrappedPayload(entry TypedEntry) (map[string]interface{}, bool) {
+	if entry.Type != wrappedLogType {
+		return nil, false
+	}
+	return wrappedPayload(entry.Entry)
+}
+
+// wrappedPayload returns the entry wrapped by the provided wrapped.1 entry.
+func wrappedPayload(entry Entry) (map[string]interface{}, bool) {
+	payload, ok := entry[payloadKey].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	payloadType, ok := payload[typeKey].(string)
+	if !ok {
+		return nil, false
+	}
+	wrapped, ok := payload[payloadType].(map[string]interface{})
+	return wrapped, ok
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenDot
+	tokenLParen
+	tokenRParen
+)
+
+type queryToken struct {
+	kind tokenKind
+	text string
+	num  float64
+	pos  int
+}
+
+func (t queryToken) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenString:
+		return strconv.Quote(t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// queryOperators contains the operators of the query language. Two-character operators must precede their
+// one-character prefixes.
+var queryOperators = []struct {
+	text string
+	kind tokenKind
+}{
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"==", tokenOp},
+	{"!=", tokenOp},
+	{"=~", tokenOp},
+	{"!~", tokenOp},
+	{"<=", tokenOp},
+	{">=", tokenOp},
+	{"<", tokenOp},
+	{">", tokenOp},
+	{"!", tokenNot},
+	{".", tokenDot},
+	{"(", tokenLParen},
+	{")", tokenRParen},
+}
+
+func lexQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	pos := 0
+	for {
+		for pos < len(query) && unicode.IsSpace(rune(query[pos])) {
+			pos++
+		}
+		if pos == len(query) {
+			return append(tokens, queryToken{kind: tokenEOF, pos: pos}), nil
+		}
+
+		rest := query[pos:]
+		switch c := rest[0]; {
+		case c == '"':
+			end := 1
+			for ; end < len(rest) && rest[end] != '"'; end++ {
+				if rest[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(rest) {
+				return nil, fmt.Errorf("unterminated string at position %d in query %q", pos, query)
+			}
+			str, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d in query %q: %v", pos, query, err)
+			}
+			tokens = append(tokens, queryToken{kind: tokenString, text: str, pos: pos})
+			pos += end + 1
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := 1
+			for end < len(rest) && (rest[end] == '.' || rest[end] == 'e' || rest[end] == 'E' || (rest[end] >= '0' && rest[end] <= '9')) {
+				end++
+			}
+			num, err := strconv.ParseFloat(rest[:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d in query %q", rest[:end], pos, query)
+			}
+			tokens = append(tokens, queryToken{kind: tokenNumber, text: rest[:end], num: num, pos: pos})
+			pos += end
+		case isIdentChar(c) && !(c >= '0' && c <= '9'):
+			end := 1
+			for end < len(rest) && isIdentChar(rest[end]) {
+				end++
+			}
+			tokens = append(tokens, queryToken{kind: tokenIdent, text: rest[:end], pos: pos})
+			pos += end
+		default:
+			matched := false
+			for _, op := range queryOperators {
+				if strings.HasPrefix(rest, op.text) {
+					tokens = append(tokens, queryToken{kind: op.kind, text: op.text, pos: pos})
+					pos += len(op.text)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d in query %q", c, pos, query)
+			}
+		}
+	}
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
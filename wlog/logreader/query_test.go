@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	queryTestSvc1 = `{"type":"service.1","time":"2018-01-01T10:02:00Z","level":"ERROR","origin":"com.palantir.foo.Bar","message":"failed","params":{"tenant":"acme","attempt":3,"my.key":"dotted"},"unsafeParams":{"user":"jdoe"},"tags":{"env":"prod"}}`
+	queryTestReq2 = `{"type":"request.2","time":"2018-01-01T10:06:00Z","method":"GET","path":"/api/v2/items/{id}","status":503,"params":{"tenant":"other"}}`
+	queryTestWrap = `{"type":"wrapped.1","entityName":"my-service","entityVersion":"1.0.0","payload":{"type":"serviceLogV1","serviceLogV1":{"type":"service.1","time":"2018-01-01T10:01:00Z","level":"WARN","message":"slow","params":{"tenant":"acme"}}}}`
+)
+
+func TestQuery(t *testing.T) {
+	for _, tc := range []struct {
+		query string
+		want  []string
+	}{
+		{`level >= WARN`, []string{"service.1", "wrapped.1"}},
+		{`level > WARN`, []string{"service.1"}},
+		{`level == "warn"`, []string{"wrapped.1"}},
+		{`params.tenant == "acme"`, []string{"service.1", "wrapped.1"}},
+		{`params.tenant != "acme"`, []string{"request.2"}},
+		{`params.attempt >= 3`, []string{"service.1"}},
+		{`params."my.key" == dotted`, []string{"service.1"}},
+		{`unsafeParams.user`, []string{"service.1"}},
+		{`!unsafeParams.user`, []string{"request.2", "wrapped.1"}},
+		{`tags.env == prod`, []string{"service.1"}},
+		{`type == "service.1"`, []string{"service.1", "wrapped.1"}},
+		{`entityName == "my-service"`, []string{"wrapped.1"}},
+		{`origin =~ "^com\\.palantir\\."`, []string{"service.1"}},
+		{`path !~ "^/api/v2/"`, []string{"service.1", "wrapped.1"}},
+		{`status >= 500 && status < 600`, []string{"request.2"}},
+		{`time >= "2018-01-01T10:00:00Z" && time < "2018-01-01T10:05:00Z"`, []string{"service.1", "wrapped.1"}},
+		{`time > "2018-01-01T10:01:30.5+00:00"`, []string{"service.1", "request.2"}},
+		{`level == ERROR || (type == "request.2" && method == GET)`, []string{"service.1", "request.2"}},
+		{`!(level >= WARN) && params.tenant == "other"`, []string{"request.2"}},
+		{`missing == null`, nil},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			q, err := logreader.ParseQuery(tc.query)
+			require.NoError(t, err)
+			r := logreader.Filter(logreader.NewReader("test", strings.NewReader(lines(queryTestSvc1, queryTestReq2, queryTestWrap))), q.Matches)
+
+			var got []string
+			for {
+				entry, err := r.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				got = append(got, entry.Type)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	for _, tc := range []struct {
+		query   string
+		wantErr string
+	}{
+		{`level >=`, `expected value at position 8 but found end of query`},
+		{`(level == WARN`, `expected ) at position 14 but found end of query`},
+		{`level == "WARN`, `unterminated string at position 9 in query "level == \"WARN"`},
+		{`message =~ "["`, "invalid regular expression at position 11: error parsing regexp: missing closing ]: `[`"},
+		{`level == WARN WARN`, `unexpected "WARN" at position 14 in query "level == WARN WARN"`},
+		{`status =~ 5`, `operator =~ at position 7 requires a string regular expression`},
+		{`level # WARN`, `unexpected character '#' at position 6 in query "level # WARN"`},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			_, err := logreader.ParseQuery(tc.query)
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
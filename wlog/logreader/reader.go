@@ -17,18 +17,16 @@ package logreader
 import (
 	"bufio"
 	"bytes"
-	"encoding/json"
 	"io"
-	"os"
 )
 
 // Entry is a single JSON entry in the log.
 type Entry map[string]interface{}
 
 // EntriesFromFile returns a slice of all of the log entries in the given file. Assumes that each line in the file
-// is a JSON object that represents a log entry.
+// is a JSON object that represents a log entry. Gzip-compressed files are decompressed transparently.
 func EntriesFromFile(file string) ([]Entry, error) {
-	logFile, err := os.Open(file)
+	logFile, err := OpenFile(file)
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +45,8 @@ func entriesFromReader(r io.Reader) ([]Entry, error) {
 	var entries []Entry
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		var currEntry Entry
-		dec := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
-		dec.UseNumber()
-
-		if err := dec.Decode(&currEntry); err != nil {
+		currEntry, err := entryFromLine(scanner.Bytes())
+		if err != nil {
 			return nil, err
 		}
 		entries = append(entries, currEntry)
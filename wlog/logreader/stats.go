@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// StatsAggregator aggregates log entries into summary statistics for fixed-size time windows. request.2 entries are
+// summarized per endpoint (method and path template), service.1 entries with level ERROR are counted per origin and
+// event.2 entries are counted per event name. Entries of other types are ignored. Fields of wrapped.1 entries are
+// resolved against their payload, so wrapped entries are included as well.
+type StatsAggregator struct {
+	window  time.Duration
+	windows map[time.Time]*windowStats
+}
+
+// NewStatsAggregator returns a new StatsAggregator that groups entries into windows of the provided size based on the
+// time of the entry. Windows are aligned to multiples of the window size since the Unix epoch. If window is 0, all of
+// the entries are aggregated into a single window. Entries without a time, or with a time that is too far from the
+// Unix epoch to be aligned, are aggregated into a separate window that has no start and end.
+func NewStatsAggregator(window time.Duration) *StatsAggregator {
+	return &StatsAggregator{
+		window:  window,
+		windows: make(map[time.Time]*windowStats),
+	}
+}
+
+// WindowStats contains the statistics for a single time window.
+type WindowStats struct {
+	// Start is the inclusive start of the window. Is nil if the aggregator does not use windows or if the window
+	// contains the entries whose time is unknown.
+	Start *time.Time `json:"start,omitempty"`
+	// End is the exclusive end of the window. Is nil if Start is nil.
+	End *time.Time `json:"end,omitempty"`
+	// Requests contains the statistics for every endpoint, ordered by path and method.
+	Requests []RequestStats `json:"requests"`
+	// ServiceErrors contains the number of service.1 ERROR entries for every origin, ordered by descending count.
+	ServiceErrors []CountStats `json:"serviceErrors"`
+	// Events contains the number of event.2 entries for every event name, ordered by descending count.
+	Events []CountStats `json:"events"`
+}
+
+// RequestStats contains the statistics for the requests made to a single endpoint.
+type RequestStats struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Count  int    `json:"count"`
+	// Latency contains the latency percentiles in microseconds.
+	Latency DistributionStats `json:"latency"`
+	// ClientErrorRate is the fraction of requests that returned a 4xx status.
+	ClientErrorRate float64 `json:"clientErrorRate"`
+	// ServerErrorRate is the fraction of requests that returned a 5xx status.
+	ServerErrorRate float64 `json:"serverErrorRate"`
+	// RequestSize contains the statistics for request sizes in bytes.
+	RequestSize SizeStats `json:"requestSize"`
+	// ResponseSize contains the statistics for response sizes in bytes.
+	ResponseSize SizeStats `json:"responseSize"`
+}
+
+// DistributionStats contains the percentiles of a distribution of values.
+type DistributionStats struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// SizeStats contains the statistics for a set of sizes.
+type SizeStats struct {
+	Total float64 `json:"total"`
+	Mean  float64 `json:"mean"`
+	Max   float64 `json:"max"`
+}
+
+// CountStats contains the number of entries for a key.
+type CountStats struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+type endpointKey struct {
+	method string
+	path   string
+}
+
+type endpointStats struct {
+	count         int
+	durations     []float64
+	clientErrors  int
+	serverErrors  int
+	requestSizes  []float64
+	responseSizes []float64
+}
+
+type windowStats struct {
+	endpoints     map[endpointKey]*endpointStats
+	serviceErrors map[string]int
+	events        map[string]int
+}
+
+// Add adds the provided entry to the statistics.
+func (a *StatsAggregator) Add(entry TypedEntry) {
+	typ, _ := LookupField(entry, typeKey)
+	switch typ {
+	case "request.2":
+		w := a.windowFor(entry)
+		key := endpointKey{
+			method: lookupString(entry, "method"),
+			path:   lookupString(entry, "path"),
+		}
+		stats, ok := w.endpoints[key]
+		if !ok {
+			stats = &endpointStats{}
+			w.endpoints[key] = stats
+		}
+		stats.count++
+		if duration, ok := lookupNumber(entry, "duration"); ok {
+			stats.durations = append(stats.durations, duration)
+		}
+		if status, ok := lookupNumber(entry, "status"); ok {
+			switch {
+			case status >= 400 && status < 500:
+				stats.clientErrors++
+			case status >= 500 && status < 600:
+				stats.serverErrors++
+			}
+		}
+		if size, ok := lookupNumber(entry, "requestSize"); ok {
+			stats.requestSizes = append(stats.requestSizes, size)
+		}
+		if size, ok := lookupNumber(entry, "responseSize"); ok {
+			stats.responseSizes = append(stats.responseSizes, size)
+		}
+	case "service.1":
+		if lookupString(entry, "level") == "ERROR" {
+			a.windowFor(entry).serviceErrors[lookupString(entry, "origin")]++
+		}
+	case "event.2":
+		a.windowFor(entry).events[lookupString(entry, "eventName")]++
+	}
+}
+
+// Stats returns the statistics for every window that contains at least one entry, ordered by start time.
+func (a *StatsAggregator) Stats() []WindowStats {
+	var starts []time.Time
+	for start := range a.windows {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool {
+		return starts[i].Before(starts[j])
+	})
+
+	var out []WindowStats
+	for _, start := range starts {
+		start := start
+		w := a.windows[start]
+		stats := WindowStats{}
+		if a.window > 0 && !start.IsZero() {
+			end := start.Add(a.window)
+			stats.Start = &start
+			stats.End = &end
+		}
+		for key, endpoint := range w.endpoints {
+			stats.Requests = append(stats.Requests, endpoint.toStats(key))
+		}
+		sort.Slice(stats.Requests, func(i, j int) bool {
+			if stats.Requests[i].Path != stats.Requests[j].Path {
+				return stats.Requests[i].Path < stats.Requests[j].Path
+			}
+			return stats.Requests[i].Method < stats.Requests[j].Method
+		})
+		stats.ServiceErrors = toCountStats(w.serviceErrors)
+		stats.Events = toCountStats(w.events)
+		out = append(out, stats)
+	}
+	return out
+}
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+func (a *StatsAggregator) windowFor(entry TypedEntry) *windowStats {
+	// the zero time is the key of the window for entries whose time is unknown
+	var start time.Time
+	// time.Time.Sub saturates for times that are more than ~292 years from the Unix epoch, such as the zero time of
+	// entries without a time, so only times that round-trip through the offset can be aligned
+	if offset := entry.Time.Sub(unixEpoch); a.window > 0 && unixEpoch.Add(offset).Equal(entry.Time) {
+		// time.Time.Truncate aligns to the zero time rather than the Unix epoch
+		rem := offset % a.window
+		if rem < 0 {
+			rem += a.window
+		}
+		start = unixEpoch.Add(offset - rem)
+	}
+	w, ok := a.windows[start]
+	if !ok {
+		w = &windowStats{
+			endpoints:     make(map[endpointKey]*endpointStats),
+			serviceErrors: make(map[string]int),
+			events:        make(map[string]int),
+		}
+		a.windows[start] = w
+	}
+	return w
+}
+
+func (s *endpointStats) toStats(key endpointKey) RequestStats {
+	return RequestStats{
+		Method:          key.method,
+		Path:            key.path,
+		Count:           s.count,
+		Latency:         distribution(s.durations),
+		ClientErrorRate: float64(s.clientErrors) / float64(s.count),
+		ServerErrorRate: float64(s.serverErrors) / float64(s.count),
+		RequestSize:     sizes(s.requestSizes),
+		ResponseSize:    sizes(s.responseSizes),
+	}
+}
+
+// distribution returns the percentiles of the provided values using the nearest-rank method.
+func distribution(vals []float64) DistributionStats {
+	if len(vals) == 0 {
+		return DistributionStats{}
+	}
+	sorted := append([]float64(nil), vals...)
+	sort.Float64s(sorted)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return DistributionStats{
+		P50: percentile(50),
+		P90: percentile(90),
+		P99: percentile(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+func sizes(vals []float64) SizeStats {
+	if len(vals) == 0 {
+		return SizeStats{}
+	}
+	var stats SizeStats
+	for _, v := range vals {
+		stats.Total += v
+		stats.Max = math.Max(stats.Max, v)
+	}
+	stats.Mean = stats.Total / float64(len(vals))
+	return stats
+}
+
+func toCountStats(counts map[string]int) []CountStats {
+	var out []CountStats
+	for k, v := range counts {
+		out = append(out, CountStats{Key: k, Count: v})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
+
+func lookupString(entry TypedEntry, key string) string {
+	val, ok := LookupField(entry, key)
+	if !ok || val == nil {
+		return ""
+	}
+	return queryValueString(val)
+}
+
+func lookupNumber(entry TypedEntry, key string) (float64, bool) {
+	val, ok := LookupField(entry, key)
+	if !ok {
+		return 0, false
+	}
+	return queryValueNumber(val)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsAggregator(t *testing.T) {
+	input := lines(
+		`{"type":"request.2","time":"2018-01-01T10:00:01Z","method":"GET","path":"/items/{id}","status":200,"duration":100,"requestSize":0,"responseSize":10}`,
+		`{"type":"request.2","time":"2018-01-01T10:00:02Z","method":"GET","path":"/items/{id}","status":404,"duration":300,"requestSize":0,"responseSize":30}`,
+		`{"type":"request.2","time":"2018-01-01T10:00:03Z","method":"GET","path":"/items/{id}","status":500,"duration":200,"requestSize":0,"responseSize":20}`,
+		`{"type":"request.2","time":"2018-01-01T10:00:04Z","method":"POST","path":"/items","status":200,"duration":50,"requestSize":100,"responseSize":0}`,
+		`{"type":"service.1","time":"2018-01-01T10:00:05Z","level":"ERROR","origin":"foo.go:10","message":"failed"}`,
+		`{"type":"service.1","time":"2018-01-01T10:00:06Z","level":"INFO","origin":"foo.go:20","message":"ok"}`,
+		`{"type":"wrapped.1","payload":{"type":"serviceLogV1","serviceLogV1":{"type":"service.1","time":"2018-01-01T10:01:05Z","level":"ERROR","origin":"foo.go:10"}}}`,
+		`{"type":"event.2","time":"2018-01-01T10:01:07Z","eventName":"b.event"}`,
+		`{"type":"event.2","time":"2018-01-01T10:01:08Z","eventName":"a.event"}`,
+		`{"type":"event.2","time":"2018-01-01T10:01:09Z","eventName":"b.event"}`,
+	)
+
+	t.Run("single window", func(t *testing.T) {
+		stats := aggregate(t, input, 0)
+		require.Len(t, stats, 1)
+		assert.Nil(t, stats[0].Start)
+		assert.Equal(t, []logreader.RequestStats{
+			{
+				Method:          "POST",
+				Path:            "/items",
+				Count:           1,
+				Latency:         logreader.DistributionStats{P50: 50, P90: 50, P99: 50, Max: 50},
+				RequestSize:     logreader.SizeStats{Total: 100, Mean: 100, Max: 100},
+				ResponseSize:    logreader.SizeStats{},
+				ClientErrorRate: 0,
+				ServerErrorRate: 0,
+			},
+			{
+				Method:          "GET",
+				Path:            "/items/{id}",
+				Count:           3,
+				Latency:         logreader.DistributionStats{P50: 200, P90: 300, P99: 300, Max: 300},
+				RequestSize:     logreader.SizeStats{},
+				ResponseSize:    logreader.SizeStats{Total: 60, Mean: 20, Max: 30},
+				ClientErrorRate: 1.0 / 3,
+				ServerErrorRate: 1.0 / 3,
+			},
+		}, stats[0].Requests)
+		assert.Equal(t, []logreader.CountStats{{Key: "foo.go:10", Count: 2}}, stats[0].ServiceErrors)
+		assert.Equal(t, []logreader.CountStats{{Key: "b.event", Count: 2}, {Key: "a.event", Count: 1}}, stats[0].Events)
+	})
+
+	t.Run("one minute windows", func(t *testing.T) {
+		stats := aggregate(t, input, time.Minute)
+		require.Len(t, stats, 2)
+		assert.Equal(t, time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC), *stats[0].Start)
+		assert.Equal(t, time.Date(2018, 1, 1, 10, 1, 0, 0, time.UTC), *stats[0].End)
+		assert.Len(t, stats[0].Requests, 2)
+		assert.Equal(t, []logreader.CountStats{{Key: "foo.go:10", Count: 1}}, stats[0].ServiceErrors)
+		assert.Empty(t, stats[0].Events)
+
+		assert.Equal(t, time.Date(2018, 1, 1, 10, 1, 0, 0, time.UTC), *stats[1].Start)
+		assert.Empty(t, stats[1].Requests)
+		assert.Equal(t, []logreader.CountStats{{Key: "foo.go:10", Count: 1}}, stats[1].ServiceErrors)
+		assert.Len(t, stats[1].Events, 2)
+	})
+
+	t.Run("windows aligned to Unix epoch", func(t *testing.T) {
+		window := 7 * time.Minute
+		stats := aggregate(t, input, window)
+		require.Len(t, stats, 1)
+		assert.Equal(t, time.Unix(1514800801/420*420, 0).UTC(), *stats[0].Start)
+		assert.Equal(t, stats[0].Start.Add(window), *stats[0].End)
+	})
+
+	t.Run("entries without time aggregated separately", func(t *testing.T) {
+		stats := aggregate(t, lines(
+			`{"type":"event.2","time":"2018-01-01T10:01:07Z","eventName":"a.event"}`,
+			`{"type":"event.2","eventName":"b.event"}`,
+			`{"type":"event.2","time":"1066-10-14T09:00:00Z","eventName":"c.event"}`,
+		), time.Minute)
+		require.Len(t, stats, 2)
+		assert.Nil(t, stats[0].Start)
+		assert.Nil(t, stats[0].End)
+		assert.Equal(t, []logreader.CountStats{{Key: "b.event", Count: 1}, {Key: "c.event", Count: 1}}, stats[0].Events)
+		assert.Equal(t, time.Date(2018, 1, 1, 10, 1, 0, 0, time.UTC), *stats[1].Start)
+		assert.Equal(t, []logreader.CountStats{{Key: "a.event", Count: 1}}, stats[1].Events)
+	})
+}
+
+func aggregate(t *testing.T, input string, window time.Duration) []logreader.WindowStats {
+	agg := logreader.NewStatsAggregator(window)
+	r := logreader.NewReader("test", strings.NewReader(input))
+	for {
+		entry, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		agg.Add(entry)
+	}
+	return agg.Stats()
+}
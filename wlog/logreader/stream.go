@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logreader
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrPending is returned by an EntryReader when no entry is currently available but more entries may become
+// available later, for example when a Follower has reached the end of the file it is following.
+var ErrPending = errors.New("no log entry available yet")
+
+// EntryReader reads log entries one at a time.
+type EntryReader interface {
+	// Next returns the next entry. Returns io.EOF if there are no more entries and ErrPending if no entry is
+	// available yet. Any other error applies only to the current entry: subsequent calls continue with the next one.
+	Next() (TypedEntry, error)
+}
+
+// LineError is returned by an EntryReader when a line could not be decoded as a log entry.
+type LineError struct {
+	Source string
+	Line   []byte
+	Err    error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("failed to decode log entry from %s: %v", e.Source, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+type reader struct {
+	source string
+	r      *bufio.Reader
+}
+
+// NewReader returns an EntryReader that reads newline-delimited JSON entries from the provided reader. The source is
+// used as the Source of the returned entries. Empty lines are skipped.
+func NewReader(source string, r io.Reader) EntryReader {
+	return &reader{
+		source: source,
+		r:      bufio.NewReader(r),
+	}
+}
+
+func (r *reader) Next() (TypedEntry, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return TypedEntry{}, err
+		}
+		if entry, ok, decodeErr := decodeLine(r.source, line); ok || decodeErr != nil {
+			return entry, decodeErr
+		}
+		if err == io.EOF {
+			return TypedEntry{}, io.EOF
+		}
+	}
+}
+
+// decodeLine decodes the provided line. Returns false if the line is empty.
+func decodeLine(source string, line []byte) (TypedEntry, bool, error) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return TypedEntry{}, false, nil
+	}
+	entry, err := entryFromLine(line)
+	if err != nil {
+		return TypedEntry{}, false, &LineError{Source: source, Line: line, Err: err}
+	}
+	return NewTypedEntry(source, entry), true, nil
+}
+
+// Stream calls fn with every entry read from r until r returns io.EOF or fn returns an error. When r returns
+// ErrPending, Stream waits for pollInterval before trying again, so Stream only returns for a reader in follow mode
+// once ctx is done. Errors for individual lines are passed to onLineErr if it is non-nil and are otherwise ignored.
+func Stream(ctx context.Context, r EntryReader, pollInterval time.Duration, fn func(TypedEntry) error, onLineErr func(*LineError)) error {
+	for {
+		entry, err := r.Next()
+		switch {
+		case err == nil:
+			if err := fn(entry); err != nil {
+				return err
+			}
+			continue
+		case err == io.EOF:
+			return nil
+		case err == ErrPending:
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(pollInterval):
+			}
+			continue
+		}
+		var lineErr *LineError
+		if !errors.As(err, &lineErr) {
+			return err
+		}
+		if onLineErr != nil {
+			onLineErr(lineErr)
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+	}
+}
@@ -51,3 +51,11 @@ func (l *warnLogger) Metric(name, typ string, params ...Param) {
 	NewFromCreator(buf, l.creator).Metric(name, typ, params...)
 	_, _ = fmt.Fprintln(l.w, wloginternal.WarnLoggerOutput("metric1log", buf.String(), 2))
 }
+
+func (l *warnLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
+func (l *warnLogger) Close() error {
+	return l.Flush()
+}
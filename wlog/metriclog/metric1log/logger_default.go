@@ -28,6 +28,14 @@ func (l *defaultLogger) Metric(name, typ string, params ...Param) {
 	l.logger.Log(ToParams(name, typ, params)...)
 }
 
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
 func ToParams(metricName, metricType string, inParams []Param) []wlog.Param {
 	outParams := make([]wlog.Param, len(defaultTypeParam)+1+len(inParams))
 	copy(outParams, defaultTypeParam)
@@ -0,0 +1,195 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric1log
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+	"time"
+
+	wloginternal "github.com/palantir/witchcraft-go-logging/wlog/internal"
+)
+
+const (
+	GaugeMetricType     = "gauge"
+	CounterMetricType   = "counter"
+	HistogramMetricType = "histogram"
+)
+
+const defaultRuntimeMetricsInterval = time.Minute
+
+type runtimeMetric struct {
+	// name is the name of the metric.1 metric
+	name string
+	// runtimeName is the name of the runtime/metrics metric that is sampled
+	runtimeName string
+	metricType  string
+}
+
+var runtimeMetrics = []runtimeMetric{
+	{name: "go.runtime.heap.objects.bytes", runtimeName: "/memory/classes/heap/objects:bytes", metricType: GaugeMetricType},
+	{name: "go.runtime.heap.goal.bytes", runtimeName: "/gc/heap/goal:bytes", metricType: GaugeMetricType},
+	{name: "go.runtime.memory.total.bytes", runtimeName: "/memory/classes/total:bytes", metricType: GaugeMetricType},
+	{name: "go.runtime.heap.allocs.bytes", runtimeName: "/gc/heap/allocs:bytes", metricType: CounterMetricType},
+	{name: "go.runtime.goroutines", runtimeName: "/sched/goroutines:goroutines", metricType: GaugeMetricType},
+	{name: "go.runtime.gomaxprocs", runtimeName: "/sched/gomaxprocs:threads", metricType: GaugeMetricType},
+	{name: "go.runtime.gc.cycles", runtimeName: "/gc/cycles/total:gc-cycles", metricType: CounterMetricType},
+	{name: "go.runtime.gc.pauses.seconds", runtimeName: "/gc/pauses:seconds", metricType: HistogramMetricType},
+	{name: "go.runtime.sched.latencies.seconds", runtimeName: "/sched/latencies:seconds", metricType: HistogramMetricType},
+	{name: "go.runtime.cpu.total.seconds", runtimeName: "/cpu/classes/total:cpu-seconds", metricType: CounterMetricType},
+	{name: "go.runtime.cpu.user.seconds", runtimeName: "/cpu/classes/user:cpu-seconds", metricType: CounterMetricType},
+	{name: "go.runtime.cpu.gc.seconds", runtimeName: "/cpu/classes/gc/total:cpu-seconds", metricType: CounterMetricType},
+}
+
+type RuntimeMetricsParam interface {
+	apply(e *runtimeMetricsEmitter)
+}
+
+type runtimeMetricsParamFunc func(e *runtimeMetricsEmitter)
+
+func (f runtimeMetricsParamFunc) apply(e *runtimeMetricsEmitter) {
+	f(e)
+}
+
+// RuntimeMetricsInterval configures the interval at which runtime metrics are emitted. The default interval of 1 minute
+// is used if the provided interval is not positive.
+func RuntimeMetricsInterval(interval time.Duration) RuntimeMetricsParam {
+	return runtimeMetricsParamFunc(func(e *runtimeMetricsEmitter) {
+		e.interval = interval
+	})
+}
+
+// RuntimeMetricsTags configures tags that are added to every emitted runtime metric.
+func RuntimeMetricsTags(tags map[string]string) RuntimeMetricsParam {
+	return runtimeMetricsParamFunc(func(e *runtimeMetricsEmitter) {
+		for k, v := range tags {
+			e.tags[k] = v
+		}
+	})
+}
+
+// EmitRuntimeMetrics samples runtime/metrics metrics of the heap, goroutines, garbage collection, scheduler latencies
+// and CPU usage on an interval and logs them as metric.1 entries using the Logger of the provided context. Gauges have
+// a "value" value and counters have a "count" value that is the total since the process started. Histograms describe
+// the observations made since the previous emission (or since the process started for the first emission): their
+// "count" value is the number of those observations and their "p50", "p95", "p99" and "max" values approximate their
+// distribution and are omitted if there were none. Metrics that are not
+// supported by the Go runtime are not emitted. EmitRuntimeMetrics blocks until the provided context is done, so it is
+// typically run in its own goroutine.
+func EmitRuntimeMetrics(ctx context.Context, params ...RuntimeMetricsParam) {
+	e := &runtimeMetricsEmitter{
+		interval:   defaultRuntimeMetricsInterval,
+		tags:       make(map[string]string),
+		samples:    make([]metrics.Sample, len(runtimeMetrics)),
+		prevCounts: make(map[string][]uint64),
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(e)
+	}
+	if e.interval <= 0 {
+		e.interval = defaultRuntimeMetricsInterval
+	}
+	for i, metric := range runtimeMetrics {
+		e.samples[i].Name = metric.runtimeName
+	}
+
+	logger := FromContext(ctx)
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.emit(logger)
+		}
+	}
+}
+
+type runtimeMetricsEmitter struct {
+	interval time.Duration
+	tags     map[string]string
+	samples  []metrics.Sample
+	// prevCounts stores the bucket counts of each histogram at the previous emission
+	prevCounts map[string][]uint64
+}
+
+func (e *runtimeMetricsEmitter) emit(logger Logger) {
+	metrics.Read(e.samples)
+	for i, metric := range runtimeMetrics {
+		var values map[string]interface{}
+		switch value := e.samples[i].Value; value.Kind() {
+		case metrics.KindUint64:
+			values = scalarValues(metric, value.Uint64())
+		case metrics.KindFloat64:
+			if v := value.Float64(); !math.IsNaN(v) && !math.IsInf(v, 0) {
+				values = scalarValues(metric, v)
+			}
+		case metrics.KindFloat64Histogram:
+			values = e.histogramValues(metric, value.Float64Histogram())
+		}
+		if values == nil {
+			continue
+		}
+		params := []Param{Values(values)}
+		if len(e.tags) > 0 {
+			params = append(params, Tags(e.tags))
+		}
+		logger.Metric(metric.name, metric.metricType, params...)
+	}
+}
+
+func scalarValues(metric runtimeMetric, value interface{}) map[string]interface{} {
+	if metric.metricType == CounterMetricType {
+		return map[string]interface{}{"count": value}
+	}
+	return map[string]interface{}{"value": value}
+}
+
+func (e *runtimeMetricsEmitter) histogramValues(metric runtimeMetric, h *metrics.Float64Histogram) map[string]interface{} {
+	// the count and quantiles are both computed over the observations made since the previous emission
+	var count uint64
+	counts := make([]uint64, len(h.Counts))
+	prevCounts := e.prevCounts[metric.runtimeName]
+	for i, c := range h.Counts {
+		counts[i] = c
+		if len(prevCounts) == len(h.Counts) {
+			counts[i] -= prevCounts[i]
+		}
+		count += counts[i]
+	}
+	// the runtime may reuse the storage of the histogram, so the counts are copied
+	e.prevCounts[metric.runtimeName] = append(prevCounts[:0], h.Counts...)
+
+	values := map[string]interface{}{"count": count}
+	for _, quantile := range []struct {
+		key   string
+		value float64
+	}{
+		{key: "p50", value: 0.5},
+		{key: "p95", value: 0.95},
+		{key: "p99", value: 0.99},
+		{key: "max", value: 1},
+	} {
+		if v, ok := wloginternal.HistogramQuantile(counts, h.Buckets, quantile.value); ok {
+			values[quantile.key] = v
+		}
+	}
+	return values
+}
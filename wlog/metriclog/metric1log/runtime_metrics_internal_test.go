@@ -0,0 +1,41 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric1log
+
+import (
+	"runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramValues(t *testing.T) {
+	e := &runtimeMetricsEmitter{prevCounts: make(map[string][]uint64)}
+	metric := runtimeMetric{name: "test.histogram", runtimeName: "/test:seconds", metricType: HistogramMetricType}
+	buckets := []float64{0, 1, 2, 3}
+
+	// the first emission covers all observations
+	values := e.histogramValues(metric, &metrics.Float64Histogram{Counts: []uint64{2, 0, 2}, Buckets: buckets})
+	assert.Equal(t, uint64(4), values["count"])
+	assert.Equal(t, float64(3), values["max"])
+
+	// later emissions only cover the observations made since the previous emission
+	values = e.histogramValues(metric, &metrics.Float64Histogram{Counts: []uint64{3, 0, 2}, Buckets: buckets})
+	assert.Equal(t, uint64(1), values["count"])
+	assert.Equal(t, float64(1), values["max"])
+
+	values = e.histogramValues(metric, &metrics.Float64Histogram{Counts: []uint64{3, 0, 2}, Buckets: buckets})
+	assert.Equal(t, map[string]interface{}{"count": uint64(0)}, values)
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric1log_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmitRuntimeMetrics(t *testing.T) {
+	w := make(chanWriter)
+	ctx, cancel := context.WithCancel(metric1log.WithLogger(context.Background(), newTestLogger(w)))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		metric1log.EmitRuntimeMetrics(ctx,
+			metric1log.RuntimeMetricsInterval(10*time.Millisecond),
+			metric1log.RuntimeMetricsTags(map[string]string{"service": "test"}),
+		)
+	}()
+	runtime.GC()
+
+	entries := make(map[string]logreader.Entry)
+	for len(entries) < 3 {
+		select {
+		case out := <-w:
+			outEntries, err := logreader.EntriesFromContent(out)
+			require.NoError(t, err)
+			for _, entry := range outEntries {
+				assert.Equal(t, "metric.1", entry["type"])
+				assert.Equal(t, map[string]interface{}{"service": "test"}, entry["tags"])
+				switch name := entry["metricName"].(string); name {
+				case "go.runtime.goroutines", "go.runtime.gc.cycles", "go.runtime.sched.latencies.seconds":
+					entries[name] = entry
+				}
+			}
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for runtime metrics")
+		}
+	}
+
+	cancel()
+	for stopped := false; !stopped; {
+		select {
+		case <-w:
+		case <-done:
+			stopped = true
+		}
+	}
+
+	goroutines := entries["go.runtime.goroutines"]
+	assert.Equal(t, metric1log.GaugeMetricType, goroutines["metricType"])
+	assert.Contains(t, goroutines["values"], "value")
+
+	gcCycles := entries["go.runtime.gc.cycles"]
+	assert.Equal(t, metric1log.CounterMetricType, gcCycles["metricType"])
+	assert.Contains(t, gcCycles["values"], "count")
+
+	schedLatencies := entries["go.runtime.sched.latencies.seconds"]
+	assert.Equal(t, metric1log.HistogramMetricType, schedLatencies["metricType"])
+	assert.Contains(t, schedLatencies["values"], "count")
+}
+
+// chanWriter sends a copy of every write to the channel.
+type chanWriter chan []byte
+
+func TestEmitRuntimeMetricsInvalidInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// a non-positive interval uses the default interval rather than panicking
+	metric1log.EmitRuntimeMetrics(ctx, metric1log.RuntimeMetricsInterval(0))
+}
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	w <- append([]byte(nil), p...)
+	return len(p), nil
+}
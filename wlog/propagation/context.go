@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+)
+
+type propagationContextKeyType string
+
+const tagsContextKey = propagationContextKeyType("propagation.tags")
+
+// ContextWithTags returns a copy of the provided context with the provided tags added to the tags stored on it. The
+// tags are also set on the svc1log.Logger of the returned context. Tags stored on a context can be propagated to other
+// processes using a Propagator.
+func ContextWithTags(ctx context.Context, tags map[string]string) context.Context {
+	if len(tags) == 0 {
+		return ctx
+	}
+	merged := make(map[string]string)
+	for k, v := range TagsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	ctx = context.WithValue(ctx, tagsContextKey, merged)
+	return svc1log.WithLoggerParams(ctx, svc1log.Tags(merged))
+}
+
+// TagsFromContext returns the tags stored on the provided context using ContextWithTags. The returned map must not be
+// modified.
+func TagsFromContext(ctx context.Context) map[string]string {
+	if tags, ok := ctx.Value(tagsContextKey).(map[string]string); ok {
+		return tags
+	}
+	return nil
+}
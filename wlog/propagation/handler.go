@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"net/http"
+)
+
+// NewHandler returns an http.Handler that restores the fields propagated in the headers of requests into their contexts
+// using propagator before serving them using next. Because tags are set on the svc1log.Logger of the context, the
+// returned handler should be wrapped by any handler that sets the logger on the context.
+func NewHandler(propagator *Propagator, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		next.ServeHTTP(w, req.WithContext(propagator.Extract(req.Context(), req.Header)))
+	})
+}
+
+// NewRoundTripper returns an http.RoundTripper that writes the fields of the contexts of requests to their headers using
+// propagator before sending them using base. If base is nil, http.DefaultTransport is used.
+func NewRoundTripper(propagator *Propagator, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		// round trippers must not modify the provided request, so the headers are set on a copy
+		outReq := req.Clone(req.Context())
+		if outReq.Header == nil {
+			outReq.Header = make(http.Header)
+		}
+		propagator.Inject(outReq.Context(), outReq.Header)
+		return base.RoundTrip(outReq)
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
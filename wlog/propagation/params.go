@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
+)
+
+const (
+	defaultBaggageHeader = "baggage"
+	defaultMaxValueSize  = 256
+	defaultMaxSize       = 4096
+)
+
+type Param interface {
+	apply(p *Propagator)
+}
+
+type paramFunc func(p *Propagator)
+
+func (f paramFunc) apply(p *Propagator) {
+	f(p)
+}
+
+// IDs configures the propagator to propagate the provided IDs set on the context using wlog.ContextWithUID,
+// wlog.ContextWithSID, wlog.ContextWithTokenID and wlog.ContextWithOrgID. Valid keys are wlog.UIDKey, wlog.SIDKey,
+// wlog.TokenIDKey and wlog.OrgIDKey; other keys are ignored.
+func IDs(keys ...string) Param {
+	return paramFunc(func(p *Propagator) {
+		for _, key := range keys {
+			switch key {
+			case wlog.UIDKey, wlog.SIDKey, wlog.TokenIDKey, wlog.OrgIDKey:
+				p.ids[key] = struct{}{}
+			}
+		}
+	})
+}
+
+// SafeParams configures the propagator to propagate the safe parameters with the provided keys that are set on the
+// context using wparams (for example, using svc1log.WithLoggerParams). Unsafe parameters are never propagated.
+func SafeParams(keys ...string) Param {
+	return paramFunc(func(p *Propagator) {
+		for _, key := range keys {
+			p.safeParams[key] = struct{}{}
+		}
+	})
+}
+
+// Tags configures the propagator to propagate the tags with the provided keys that are set on the context using
+// ContextWithTags.
+func Tags(keys ...string) Param {
+	return paramFunc(func(p *Propagator) {
+		for _, key := range keys {
+			p.tags[key] = struct{}{}
+		}
+	})
+}
+
+// BaggageHeader configures the propagator to propagate fields as entries of the header with the provided name, which
+// uses the format of the W3C "baggage" header. Fields are propagated using the "baggage" header by default.
+func BaggageHeader(name string) Param {
+	return paramFunc(func(p *Propagator) {
+		p.baggageHeader = name
+		p.headerPrefix = ""
+	})
+}
+
+// HeaderPrefix configures the propagator to propagate every field as a separate header whose name is the provided
+// prefix followed by the key of the field, such as "X-Context-Uid", "X-Context-Param-TenantId" or
+// "X-Context-Tag-JobId" for the prefix "X-Context-". Because header names are case-insensitive, keys of propagated
+// params and tags should not differ only in case.
+func HeaderPrefix(prefix string) Param {
+	return paramFunc(func(p *Propagator) {
+		p.baggageHeader = ""
+		p.headerPrefix = prefix
+	})
+}
+
+// MaxValueSize configures the maximum size in bytes of the encoded value of a propagated field. Fields with larger
+// values are neither sent nor accepted. The default is 256 bytes.
+func MaxValueSize(size int) Param {
+	return paramFunc(func(p *Propagator) {
+		p.maxValueSize = size
+	})
+}
+
+// MaxSize configures the maximum total size in bytes of the encoded keys and values of the propagated fields. Fields
+// are sent and accepted in the order IDs, params and tags, each ordered by key, until the limit is reached. The default
+// is 4096 bytes.
+func MaxSize(size int) Param {
+	return paramFunc(func(p *Propagator) {
+		p.maxSize = size
+	})
+}
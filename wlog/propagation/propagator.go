@@ -0,0 +1,312 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	wloginternal "github.com/palantir/witchcraft-go-logging/wlog/internal"
+	wparams "github.com/palantir/witchcraft-go-params"
+)
+
+// Propagator propagates selected logging fields of a context, such as IDs, safe params and tags, across process
+// boundaries using HTTP headers. Clients call Inject to write the fields of the context of an outgoing request to its
+// headers and servers call Extract to restore the fields of an incoming request into its context. Only fields whose
+// keys are explicitly configured are sent and accepted.
+type Propagator struct {
+	ids        map[string]struct{}
+	safeParams map[string]struct{}
+	tags       map[string]struct{}
+
+	baggageHeader string
+	headerPrefix  string
+
+	maxValueSize int
+	maxSize      int
+}
+
+// NewPropagator returns a Propagator configured using the provided parameters. By default, no fields are propagated and
+// fields are written to the "baggage" header.
+func NewPropagator(params ...Param) *Propagator {
+	p := &Propagator{
+		ids:           make(map[string]struct{}),
+		safeParams:    make(map[string]struct{}),
+		tags:          make(map[string]struct{}),
+		baggageHeader: defaultBaggageHeader,
+		maxValueSize:  defaultMaxValueSize,
+		maxSize:       defaultMaxSize,
+	}
+	for _, param := range params {
+		if param == nil {
+			continue
+		}
+		param.apply(p)
+	}
+	return p
+}
+
+type fieldKind int
+
+const (
+	idField fieldKind = iota
+	safeParamField
+	tagField
+)
+
+type field struct {
+	kind  fieldKind
+	key   string
+	value string
+}
+
+// Inject writes the configured fields set on the provided context to header. When a baggage header is used, entries of
+// the header that are not written by the propagator are preserved.
+func (p *Propagator) Inject(ctx context.Context, header http.Header) {
+	var fields []field
+	for _, key := range sortedKeys(p.ids) {
+		if id := idFromContext(ctx, key); id != nil {
+			fields = append(fields, field{kind: idField, key: key, value: *id})
+		}
+	}
+	safeParams, _ := wparams.SafeAndUnsafeParamsFromContext(ctx)
+	for _, key := range sortedKeys(p.safeParams) {
+		if val, ok := safeParams[key]; ok {
+			fields = append(fields, field{kind: safeParamField, key: key, value: fmt.Sprint(val)})
+		}
+	}
+	tags := TagsFromContext(ctx)
+	for _, key := range sortedKeys(p.tags) {
+		if val, ok := tags[key]; ok {
+			fields = append(fields, field{kind: tagField, key: key, value: val})
+		}
+	}
+
+	var entries []string
+	size := 0
+	for _, f := range fields {
+		name, value := p.fieldName(f.kind, f.key), escape(f.value, isBaggageOctet)
+		if len(value) > p.maxValueSize {
+			continue
+		}
+		if size += len(name) + len(value); size > p.maxSize {
+			break
+		}
+		if p.headerPrefix != "" {
+			header.Set(name, value)
+			continue
+		}
+		entries = append(entries, name+"="+value)
+	}
+	if len(entries) == 0 {
+		return
+	}
+	// preserve the entries of the baggage header that are not written by the propagator
+	for _, entry := range splitBaggage(header.Values(p.baggageHeader)) {
+		if name, _ := parseBaggageEntry(entry); !p.isFieldName(name) {
+			entries = append(entries, entry)
+		}
+	}
+	header.Set(p.baggageHeader, strings.Join(entries, ","))
+}
+
+// Extract returns a copy of the provided context with the configured fields in header restored: IDs are set using
+// functions such as wlog.ContextWithUID, safe params are set using wparams and tags are set using ContextWithTags.
+// Because params are transmitted as strings, restored params have string values. Tags are set on the svc1log.Logger of
+// the context, so Extract should be called after the logger has been set on the context.
+func (p *Propagator) Extract(ctx context.Context, header http.Header) context.Context {
+	var values map[string]string
+	if p.headerPrefix == "" {
+		values = make(map[string]string)
+		for _, entry := range splitBaggage(header.Values(p.baggageHeader)) {
+			name, value := parseBaggageEntry(entry)
+			if _, ok := values[name]; !ok && p.isFieldName(name) {
+				values[name] = value
+			}
+		}
+	}
+	size := 0
+	// accept returns the decoded value of the field with the provided kind and key if it is present and within the
+	// size limits.
+	accept := func(kind fieldKind, key string) (string, bool) {
+		name := p.fieldName(kind, key)
+		var encoded string
+		if values != nil {
+			encoded = values[name]
+		} else {
+			encoded = header.Get(name)
+		}
+		if encoded == "" || len(encoded) > p.maxValueSize {
+			return "", false
+		}
+		if size += len(name) + len(encoded); size > p.maxSize {
+			return "", false
+		}
+		value, err := url.PathUnescape(encoded)
+		if err != nil {
+			return "", false
+		}
+		return value, true
+	}
+
+	for _, key := range sortedKeys(p.ids) {
+		if value, ok := accept(idField, key); ok {
+			ctx = contextWithID(ctx, key, value)
+		}
+	}
+	safeParams := make(map[string]interface{})
+	for _, key := range sortedKeys(p.safeParams) {
+		if value, ok := accept(safeParamField, key); ok {
+			safeParams[key] = value
+		}
+	}
+	if len(safeParams) > 0 {
+		ctx = wparams.ContextWithSafeParams(ctx, safeParams)
+	}
+	tags := make(map[string]string)
+	for _, key := range sortedKeys(p.tags) {
+		if value, ok := accept(tagField, key); ok {
+			tags[key] = value
+		}
+	}
+	return ContextWithTags(ctx, tags)
+}
+
+// fieldName returns the name of the baggage entry or header used to propagate the field with the provided kind and
+// key.
+func (p *Propagator) fieldName(kind fieldKind, key string) string {
+	key = escape(key, isTokenChar)
+	if p.headerPrefix != "" {
+		switch kind {
+		case safeParamField:
+			return p.headerPrefix + "Param-" + key
+		case tagField:
+			return p.headerPrefix + "Tag-" + key
+		}
+		return p.headerPrefix + key
+	}
+	switch kind {
+	case safeParamField:
+		return "param." + key
+	case tagField:
+		return "tag." + key
+	}
+	return key
+}
+
+// isFieldName returns true if name is the baggage entry name of a configured field.
+func (p *Propagator) isFieldName(name string) bool {
+	for kind, keys := range map[fieldKind]map[string]struct{}{idField: p.ids, safeParamField: p.safeParams, tagField: p.tags} {
+		for key := range keys {
+			if p.fieldName(kind, key) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func idFromContext(ctx context.Context, key string) *string {
+	switch key {
+	case wlog.UIDKey:
+		return wloginternal.IDFromContext(ctx, wloginternal.UIDKey)
+	case wlog.SIDKey:
+		return wloginternal.IDFromContext(ctx, wloginternal.SIDKey)
+	case wlog.TokenIDKey:
+		return wloginternal.IDFromContext(ctx, wloginternal.TokenIDKey)
+	case wlog.OrgIDKey:
+		return wloginternal.IDFromContext(ctx, wloginternal.OrgIDKey)
+	}
+	return nil
+}
+
+func contextWithID(ctx context.Context, key, id string) context.Context {
+	switch key {
+	case wlog.UIDKey:
+		return wlog.ContextWithUID(ctx, id)
+	case wlog.SIDKey:
+		return wlog.ContextWithSID(ctx, id)
+	case wlog.TokenIDKey:
+		return wlog.ContextWithTokenID(ctx, id)
+	case wlog.OrgIDKey:
+		return wlog.ContextWithOrgID(ctx, id)
+	}
+	return ctx
+}
+
+// splitBaggage returns the entries of the provided baggage header values.
+func splitBaggage(headerValues []string) []string {
+	var entries []string
+	for _, headerValue := range headerValues {
+		for _, entry := range strings.Split(headerValue, ",") {
+			if entry = strings.TrimSpace(entry); entry != "" {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// parseBaggageEntry returns the name and the still-encoded value of the provided baggage entry. Properties of the
+// entry are ignored.
+func parseBaggageEntry(entry string) (name, value string) {
+	entry, _, _ = strings.Cut(entry, ";")
+	name, value, _ = strings.Cut(entry, "=")
+	return strings.TrimSpace(name), strings.TrimSpace(value)
+}
+
+// escape percent-encodes the bytes of s for which keep returns false and the '%' byte.
+func escape(s string, keep func(b byte) bool) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if b := s[i]; b != '%' && keep(b) {
+			sb.WriteByte(b)
+		} else {
+			sb.WriteByte('%')
+			sb.WriteByte(hex[b>>4])
+			sb.WriteByte(hex[b&0x0F])
+		}
+	}
+	return sb.String()
+}
+
+// isBaggageOctet returns true if b can appear unencoded in the value of a baggage entry.
+func isBaggageOctet(b byte) bool {
+	return b > ' ' && b < 0x7F && b != '"' && b != ',' && b != ';' && b != '\\'
+}
+
+// isTokenChar returns true if b can appear in an HTTP token such as a header name or the name of a baggage entry.
+func isTokenChar(b byte) bool {
+	switch {
+	case b >= 'a' && b <= 'z', b >= 'A' && b <= 'Z', b >= '0' && b <= '9':
+		return true
+	}
+	return strings.IndexByte("!#$&'*+-.^_`|~", b) >= 0
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propagation_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/propagation"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	wparams "github.com/palantir/witchcraft-go-params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropagatorBaggage(t *testing.T) {
+	propagator := propagation.NewPropagator(
+		propagation.IDs(wlog.UIDKey, wlog.OrgIDKey),
+		propagation.SafeParams("tenantId", "unsafeKey"),
+		propagation.Tags("jobId"),
+	)
+	header := http.Header{"Baggage": {"other=1;prop=val", "param.tenantId=stale"}}
+	propagator.Inject(clientContext(), header)
+	assert.Equal(t, "uid=user-1,param.tenantId=tenant%201,tag.jobId=job%2C1,other=1;prop=val", header.Get("baggage"))
+
+	svcBuf := &bytes.Buffer{}
+	ctx := svc1log.WithLogger(context.Background(), svc1log.NewFromCreator(svcBuf, wlog.InfoLevel, wlog.NewJSONMarshalLoggerProvider().NewLeveledLogger))
+	ctx = propagator.Extract(ctx, header)
+	svc1log.FromContext(ctx).Info("message")
+
+	entries, err := logreader.EntriesFromContent(svcBuf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "user-1", entries[0]["uid"])
+	assert.Nil(t, entries[0]["sid"])
+	assert.Equal(t, map[string]interface{}{"tenantId": "tenant 1"}, entries[0]["params"])
+	assert.Empty(t, entries[0]["unsafeParams"])
+	assert.Equal(t, map[string]interface{}{"jobId": "job,1"}, entries[0]["tags"])
+	assert.Equal(t, map[string]string{"jobId": "job,1"}, propagation.TagsFromContext(ctx))
+}
+
+func TestPropagatorHeaderPrefix(t *testing.T) {
+	propagator := propagation.NewPropagator(
+		propagation.IDs(wlog.UIDKey),
+		propagation.SafeParams("tenantId"),
+		propagation.Tags("jobId"),
+		propagation.HeaderPrefix("X-Context-"),
+	)
+	header := http.Header{}
+	propagator.Inject(clientContext(), header)
+	assert.Equal(t, http.Header{
+		"X-Context-Uid":            {"user-1"},
+		"X-Context-Param-Tenantid": {"tenant%201"},
+		"X-Context-Tag-Jobid":      {"job%2C1"},
+	}, header)
+
+	ctx := propagator.Extract(context.Background(), header)
+	safeParams, _ := wparams.SafeAndUnsafeParamsFromContext(ctx)
+	assert.Equal(t, map[string]interface{}{"tenantId": "tenant 1"}, safeParams)
+	assert.Equal(t, map[string]string{"jobId": "job,1"}, propagation.TagsFromContext(ctx))
+}
+
+func TestPropagatorSizeLimits(t *testing.T) {
+	ctx := wparams.ContextWithSafeParams(context.Background(), map[string]interface{}{
+		"a": "1",
+		"b": strings.Repeat("x", 20),
+		"c": "3",
+		"d": "4",
+	})
+	propagator := propagation.NewPropagator(
+		propagation.SafeParams("a", "b", "c", "d"),
+		propagation.MaxValueSize(10),
+		propagation.MaxSize(20),
+	)
+	header := http.Header{}
+	propagator.Inject(ctx, header)
+	// "b" exceeds the value size and "d" exceeds the total size
+	assert.Equal(t, "param.a=1,param.c=3", header.Get("baggage"))
+
+	header.Set("baggage", "param.a=1,param.b="+strings.Repeat("x", 20)+",param.c=3,param.d=4")
+	safeParams, _ := wparams.SafeAndUnsafeParamsFromContext(propagator.Extract(context.Background(), header))
+	assert.Equal(t, map[string]interface{}{"a": "1", "c": "3"}, safeParams)
+}
+
+func TestHandlerAndRoundTripper(t *testing.T) {
+	propagator := propagation.NewPropagator(
+		propagation.IDs(wlog.UIDKey),
+		propagation.SafeParams("tenantId"),
+		propagation.Tags("jobId"),
+	)
+	var serverCtx context.Context
+	server := httptest.NewServer(propagation.NewHandler(propagator, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverCtx = r.Context()
+	})))
+	defer server.Close()
+
+	req, err := http.NewRequestWithContext(clientContext(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: propagation.NewRoundTripper(propagator, nil)}).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	// the provided request is not modified
+	assert.Empty(t, req.Header)
+
+	require.NotNil(t, serverCtx)
+	safeParams, _ := wparams.SafeAndUnsafeParamsFromContext(serverCtx)
+	assert.Equal(t, map[string]interface{}{"tenantId": "tenant 1"}, safeParams)
+	assert.Equal(t, map[string]string{"jobId": "job,1"}, propagation.TagsFromContext(serverCtx))
+}
+
+func clientContext() context.Context {
+	ctx := wlog.ContextWithUID(context.Background(), "user-1")
+	ctx = wlog.ContextWithSID(ctx, "session-1")
+	ctx = svc1log.WithLoggerParams(ctx, svc1log.SafeParam("tenantId", "tenant 1"), svc1log.UnsafeParam("unsafeKey", "secret"))
+	return propagation.ContextWithTags(ctx, map[string]string{"jobId": "job,1"})
+}
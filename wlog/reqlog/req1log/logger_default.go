@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req1log
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+)
+
+type defaultLogger struct {
+	logger       wlog.Logger
+	idsExtractor extractor.IDsFromRequest
+
+	pathParamPerms   ParamPerms
+	queryParamPerms  ParamPerms
+	headerParamPerms ParamPerms
+}
+
+func (l *defaultLogger) Request(r Request) {
+	l.logger.Log(ToParams(r, l.idsExtractor, l.pathParamPerms, l.queryParamPerms, l.headerParamPerms)...)
+}
+
+func (l *defaultLogger) PathParamPerms() ParamPerms {
+	return l.pathParamPerms
+}
+
+func (l *defaultLogger) QueryParamPerms() ParamPerms {
+	return l.queryParamPerms
+}
+
+func (l *defaultLogger) HeaderParamPerms() ParamPerms {
+	return l.headerParamPerms
+}
+
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
+func ToParams(r Request, idsExtractor extractor.IDsFromRequest, pathParamPerms, queryParamPerms, headerParamPerms ParamPerms) []wlog.Param {
+	reqPath := r.Request.URL.Path
+	if r.RouteInfo.Template != "" {
+		reqPath = r.RouteInfo.Template
+	}
+
+	// extract IDs from request
+	idsMap := idsExtractor.ExtractIDs(r.Request)
+
+	return append([]wlog.Param{
+		wlog.StringParam(wlog.TypeKey, TypeValue),
+		wlog.StringParam(wlog.TimeKey, time.Now().Format(time.RFC3339Nano)),
+		wlog.OptionalStringParam(methodKey, r.Request.Method),
+		wlog.StringParam(protocolKey, r.Request.Proto),
+		wlog.StringParam(pathKey, reqPath),
+		wlog.IntParam(statusKey, int32(r.ResponseStatus)),
+		// request.1 records sizes as strings to allow for large numbers
+		wlog.StringParam(requestSizeKey, strconv.FormatInt(r.Request.ContentLength, 10)),
+		wlog.StringParam(responseSizeKey, strconv.FormatInt(r.ResponseSize, 10)),
+		wlog.Int64Param(durationKey, r.Duration.Nanoseconds()/1000),
+		wlog.OptionalStringParam(wlog.UIDKey, idsMap[wlog.UIDKey]),
+		wlog.OptionalStringParam(wlog.SIDKey, idsMap[wlog.SIDKey]),
+		wlog.OptionalStringParam(wlog.TokenIDKey, idsMap[wlog.TokenIDKey]),
+		wlog.OptionalStringParam(traceIDKey, idsMap[traceIDKey]),
+	}, parseRequestParams(r, pathParamPerms, queryParamPerms, headerParamPerms)...)
+}
+
+// parseRequestParams parses the path, header and query parameters. If any of the parameters are in a respective
+// "forbidden" list, they are not logged at all. Otherwise, if a parameter is whitelisted it is added to the safe map for
+// its kind (pathParams, queryParams or headerParams) and is added to unsafeParams otherwise. Parameters are classified
+// using req2log.ClassifyParam, so redaction rules are applied in the same way as for request.2 entries. If a single key has
+// multiple values, the value for that key in the returned field will be a slice that contains all of the values for the
+// key.
+func parseRequestParams(r Request, pathParamPerms, queryParamPerms, headerParamPerms ParamPerms) []wlog.Param {
+	safePathMap := make(map[string]interface{})
+	safeQueryMap := make(map[string]interface{})
+	safeHeaderMap := make(map[string]interface{})
+	unsafeMap := make(map[string]interface{})
+
+	for pathParamKey, pathParamVal := range r.RouteInfo.PathParams {
+		req2log.ClassifyParam("", pathParamKey, pathParamVal, safePathMap, unsafeMap, pathParamPerms, r.PathParamPerms)
+	}
+	for k, valSlice := range r.Request.URL.Query() {
+		for _, v := range valSlice {
+			req2log.ClassifyParam("", k, v, safeQueryMap, unsafeMap, queryParamPerms, r.QueryParamPerms)
+		}
+	}
+	for k := range r.Request.Header {
+		req2log.ClassifyParam("", k, r.Request.Header.Get(k), safeHeaderMap, unsafeMap, headerParamPerms, r.HeaderParamPerms)
+	}
+	return []wlog.Param{
+		anyMapParam(pathParamsKey, safePathMap),
+		anyMapParam(queryParamsKey, safeQueryMap),
+		anyMapParam(headerParamsKey, safeHeaderMap),
+		anyMapParam(wlog.UnsafeParamsKey, unsafeMap),
+	}
+}
+
+func anyMapParam(key string, m map[string]interface{}) wlog.Param {
+	return wlog.NewParam(func(entry wlog.LogEntry) {
+		if len(m) > 0 {
+			entry.AnyMapValue(key, m)
+		}
+	})
+}
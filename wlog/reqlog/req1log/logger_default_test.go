@@ -0,0 +1,44 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req1log_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToParamsRedaction(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/path?token=secret&page=2", nil)
+	queryPerms := req2log.CombinedParamPerms(
+		req1log.NewParamPerms([]string{"page", "token"}, nil),
+		req2log.NewRuleParamPerms(req2log.RedactedParamRule(req2log.ExactParamNames("token"))),
+	)
+
+	entry := wlog.NewMapLogEntry()
+	wlog.ApplyParams(entry, req1log.ToParams(req1log.Request{
+		Request:        req,
+		ResponseStatus: http.StatusOK,
+	}, extractor.NewDefaultIDsExtractor(), nil, queryPerms, nil))
+	values := entry.AllValues()
+	assert.Equal(t, map[string]interface{}{"page": "2", "token": req2log.RedactedValue}, values["queryParams"])
+	assert.Nil(t, values[wlog.UnsafeParamsKey])
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req1log
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+)
+
+// ParamPerms determines whether parameters are safe or forbidden. It is the same type as req2log.ParamPerms so that
+// request.1 and request.2 loggers can share permissions and classify parameters in the same way.
+type ParamPerms = req2log.ParamPerms
+
+// NewParamPerms returns ParamPerms for the provided safe and forbidden parameters. See req2log.NewParamPerms.
+func NewParamPerms(safe, forbidden []string) ParamPerms {
+	return req2log.NewParamPerms(safe, forbidden)
+}
+
+// CombinedParamPerms returns ParamPerms that combine the provided ParamPerms. See req2log.CombinedParamPerms.
+func CombinedParamPerms(perms ...ParamPerms) ParamPerms {
+	return req2log.CombinedParamPerms(perms...)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req1log
+
+import (
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+)
+
+// defaultRequestParamPerms uses the default header permissions of req2log, which does not log response headers.
+var defaultRequestParamPerms RequestParamPerms = &requestParamPermsImpl{
+	headerParamPerms: req2log.DefaultRequestParamPerms().HeaderParamPerms(),
+}
+
+func DefaultRequestParamPerms() RequestParamPerms {
+	return defaultRequestParamPerms
+}
+
+func SetRequestParamPerms(perms RequestParamPerms) {
+	defaultRequestParamPerms = perms
+}
+
+type requestParamPermsImpl struct {
+	pathParamPerms   ParamPerms
+	queryParamPerms  ParamPerms
+	headerParamPerms ParamPerms
+}
+
+func (r *requestParamPermsImpl) PathParamPerms() ParamPerms {
+	return r.pathParamPerms
+}
+
+func (r *requestParamPermsImpl) QueryParamPerms() ParamPerms {
+	return r.queryParamPerms
+}
+
+func (r *requestParamPermsImpl) HeaderParamPerms() ParamPerms {
+	return r.headerParamPerms
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+const (
+	// ClientIPParamKey is the key of the parameter that contains the resolved IP address of the client. The parameter
+	// is classified using the header parameter permissions.
+	ClientIPParamKey = "clientIp"
+	// ProxyChainParamKey is the key of the parameter that contains the addresses of the trusted proxies that forwarded
+	// the request, ordered from the proxy closest to the client to the proxy closest to the server. The parameter is
+	// classified using the header parameter permissions.
+	ProxyChainParamKey = "proxyChain"
+)
+
+// ClientIPResolver determines the IP address of the client that sent a request.
+type ClientIPResolver struct {
+	// TrustedProxies are the networks of the proxies whose "Forwarded" and "X-Forwarded-For" headers are trusted.
+	TrustedProxies []netip.Prefix
+	// IPv4PrefixLen is the number of bits an IPv4 client address is truncated to. Addresses are not truncated if 0.
+	IPv4PrefixLen int
+	// IPv6PrefixLen is the number of bits an IPv6 client address is truncated to. Addresses are not truncated if 0.
+	IPv6PrefixLen int
+}
+
+// Resolve returns the IP address of the client that sent req and the addresses of the trusted proxies that forwarded
+// it. Truncated client addresses are returned in CIDR notation. The returned client address is empty if the remote
+// address of req is not a valid IP address.
+func (c *ClientIPResolver) Resolve(req *http.Request) (clientIP string, proxyChain []string) {
+	clientAddr, proxyAddrs := ResolveClientIP(req, c.TrustedProxies)
+	if !clientAddr.IsValid() {
+		return "", nil
+	}
+	for _, proxyAddr := range proxyAddrs {
+		proxyChain = append(proxyChain, proxyAddr.String())
+	}
+	return c.truncate(clientAddr), proxyChain
+}
+
+func (c *ClientIPResolver) truncate(addr netip.Addr) string {
+	bits := c.IPv6PrefixLen
+	if addr.Is4() {
+		bits = c.IPv4PrefixLen
+	}
+	if bits <= 0 || bits >= addr.BitLen() {
+		return addr.String()
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return addr.String()
+	}
+	return prefix.String()
+}
+
+// ResolveClientIP returns the IP address of the client that sent req and the addresses of the trusted proxies that
+// forwarded it, ordered from the proxy closest to the client to the proxy closest to the server.
+//
+// The remote address of req is the starting point. While the current address is in one of the trusted networks, the
+// address it forwarded the request for is taken from the end of the "Forwarded" header (RFC 7239) or, if that header is
+// not present, the "X-Forwarded-For" header. Resolution stops at the first entry that is not a valid IP address, such
+// as an obfuscated identifier or "unknown". The returned client address is invalid if the remote address of req is not
+// a valid IP address.
+func ResolveClientIP(req *http.Request, trustedProxies []netip.Prefix) (netip.Addr, []netip.Addr) {
+	clientAddr, ok := parseNodeAddr(req.RemoteAddr)
+	if !ok {
+		return netip.Addr{}, nil
+	}
+	var proxyAddrs []netip.Addr
+	hops := forwardedFor(req.Header)
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(clientAddr, trustedProxies); i-- {
+		hopAddr, ok := parseNodeAddr(hops[i])
+		if !ok {
+			break
+		}
+		proxyAddrs = append([]netip.Addr{clientAddr}, proxyAddrs...)
+		clientAddr = hopAddr
+	}
+	return clientAddr, proxyAddrs
+}
+
+func isTrustedProxy(addr netip.Addr, trustedProxies []netip.Prefix) bool {
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// forwardedFor returns the nodes that the request was forwarded for in the order they were added. The values of the
+// "for" parameters of the "Forwarded" header are used if the header is present and the values of the "X-Forwarded-For"
+// header are used otherwise.
+func forwardedFor(header http.Header) []string {
+	var hops []string
+	if forwarded := header.Values("Forwarded"); len(forwarded) > 0 {
+		for _, v := range forwarded {
+			for _, element := range strings.Split(v, ",") {
+				for _, pair := range strings.Split(element, ";") {
+					key, val, ok := strings.Cut(strings.TrimSpace(pair), "=")
+					if ok && strings.EqualFold(key, "for") {
+						hops = append(hops, strings.Trim(val, `"`))
+					}
+				}
+			}
+		}
+		return hops
+	}
+	for _, v := range header.Values("X-Forwarded-For") {
+		for _, hop := range strings.Split(v, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	return hops
+}
+
+// parseNodeAddr parses the IP address of a node that is either an IP address or an IP address and port, where IPv6
+// addresses with a port are enclosed in square brackets. IPv4-mapped IPv6 addresses are returned as IPv4 addresses.
+func parseNodeAddr(node string) (netip.Addr, bool) {
+	if addrPort, err := netip.ParseAddrPort(node); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	// the "Forwarded" header encloses IPv6 addresses in square brackets even if they do not have a port
+	addr, err := netip.ParseAddr(strings.TrimSuffix(strings.TrimPrefix(node, "["), "]"))
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log_test
+
+import (
+	"net/http"
+	"net/netip"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIPResolver(t *testing.T) {
+	trustedProxies := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("2001:db8:ffff::/48"),
+	}
+	for _, tc := range []struct {
+		name           string
+		remoteAddr     string
+		header         http.Header
+		trustedProxies []netip.Prefix
+		ipv4PrefixLen  int
+		ipv6PrefixLen  int
+		wantClientIP   string
+		wantProxyChain []string
+	}{
+		{
+			name:         "remote address without trusted proxies",
+			remoteAddr:   "203.0.113.7:52000",
+			header:       http.Header{"X-Forwarded-For": {"198.51.100.1"}},
+			wantClientIP: "203.0.113.7",
+		},
+		{
+			name:           "X-Forwarded-For through trusted proxies",
+			remoteAddr:     "10.0.0.2:52000",
+			header:         http.Header{"X-Forwarded-For": {"198.51.100.1, 203.0.113.7", "10.0.0.1"}},
+			trustedProxies: trustedProxies,
+			wantClientIP:   "203.0.113.7",
+			wantProxyChain: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name:           "X-Forwarded-For with only trusted proxies uses first entry",
+			remoteAddr:     "10.0.0.2:52000",
+			header:         http.Header{"X-Forwarded-For": {"10.0.0.3, 10.0.0.1"}},
+			trustedProxies: trustedProxies,
+			wantClientIP:   "10.0.0.3",
+			wantProxyChain: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name:           "Forwarded takes precedence over X-Forwarded-For",
+			remoteAddr:     "[2001:db8:ffff::1]:52000",
+			header:         http.Header{"Forwarded": {`for="[2001:db8:1::7]:4711";proto=https, For=10.0.0.1`}, "X-Forwarded-For": {"198.51.100.1"}},
+			trustedProxies: trustedProxies,
+			wantClientIP:   "2001:db8:1::7",
+			wantProxyChain: []string{"10.0.0.1", "2001:db8:ffff::1"},
+		},
+		{
+			name:           "resolution stops at obfuscated identifier",
+			remoteAddr:     "10.0.0.2:52000",
+			header:         http.Header{"Forwarded": {"for=198.51.100.1, for=_hidden"}},
+			trustedProxies: trustedProxies,
+			wantClientIP:   "10.0.0.2",
+		},
+		{
+			name:           "truncated addresses",
+			remoteAddr:     "10.0.0.2:52000",
+			header:         http.Header{"X-Forwarded-For": {"203.0.113.7"}},
+			trustedProxies: trustedProxies,
+			ipv4PrefixLen:  24,
+			ipv6PrefixLen:  48,
+			wantClientIP:   "203.0.113.0/24",
+			wantProxyChain: []string{"10.0.0.2"},
+		},
+		{
+			name:          "truncated IPv6 address",
+			remoteAddr:    "[2001:db8:1:2::7]:52000",
+			ipv4PrefixLen: 24,
+			ipv6PrefixLen: 48,
+			wantClientIP:  "2001:db8:1::/48",
+		},
+		{
+			name:         "IPv4-mapped IPv6 remote address",
+			remoteAddr:   "[::ffff:203.0.113.7]:52000",
+			wantClientIP: "203.0.113.7",
+		},
+		{
+			name:       "invalid remote address",
+			remoteAddr: "pipe",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resolver := &req2log.ClientIPResolver{
+				TrustedProxies: tc.trustedProxies,
+				IPv4PrefixLen:  tc.ipv4PrefixLen,
+				IPv6PrefixLen:  tc.ipv6PrefixLen,
+			}
+			clientIP, proxyChain := resolver.Resolve(&http.Request{RemoteAddr: tc.remoteAddr, Header: tc.header})
+			assert.Equal(t, tc.wantClientIP, clientIP)
+			assert.Equal(t, tc.wantProxyChain, proxyChain)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+)
+
+// RouteInfoResolver returns the RouteInfo for the provided request. It is called after the request has been handled.
+type RouteInfoResolver func(req *http.Request) RouteInfo
+
+type HandlerParam interface {
+	apply(h *handler)
+}
+
+type handlerParamFunc func(h *handler)
+
+func (f handlerParamFunc) apply(h *handler) {
+	f(h)
+}
+
+// HandlerRouteInfoResolver configures the handler to use the provided resolver to determine the path template and path
+// parameters of requests. If no resolver is provided, entries are logged with the path of the request URL and no path
+// parameters.
+func HandlerRouteInfoResolver(resolver RouteInfoResolver) HandlerParam {
+	return handlerParamFunc(func(h *handler) {
+		h.routeInfoResolver = resolver
+	})
+}
+
+// HandlerIDsExtractor configures the extractor used to determine the IDs set on the loggers stored in the request
+// context. Uses extractor.NewDefaultIDsExtractor if not specified.
+func HandlerIDsExtractor(idsExtractor extractor.IDsFromRequest) HandlerParam {
+	return handlerParamFunc(func(h *handler) {
+		h.idsExtractor = idsExtractor
+	})
+}
+
+// HandlerSvc1Logger configures the handler to set the provided logger on the context of every request. The logger
+// stored on the context has the uid, sid and traceId of the request set on it.
+func HandlerSvc1Logger(logger svc1log.Logger) HandlerParam {
+	return handlerParamFunc(func(h *handler) {
+		h.svc1Logger = logger
+	})
+}
+
+// HandlerEvt2Logger configures the handler to set the provided logger on the context of every request. The logger
+// stored on the context has the uid, sid and traceId of the request set on it.
+func HandlerEvt2Logger(logger evt2log.Logger) HandlerParam {
+	return handlerParamFunc(func(h *handler) {
+		h.evt2Logger = logger
+	})
+}
+
+// HandlerAudit2Logger configures the handler to set the provided logger on the context of every request. The logger
+// stored on the context has the uid, sid and traceId of the request set on it.
+func HandlerAudit2Logger(logger audit2log.Logger) HandlerParam {
+	return handlerParamFunc(func(h *handler) {
+		h.audit2Logger = logger
+	})
+}
+
+// NewHandler returns an http.Handler that serves requests using next and logs a request.2 entry using the provided
+// logger once next returns. If next panics, the request is logged with status 500 before the panic continues. The
+// status and size of the response are recorded by wrapping the http.ResponseWriter provided to next. If the request
+// does not specify its content length, the size of the request is the number of bytes read from its body. The headers
+// and trailers of the response are logged with the response header parameter permissions of the logger.
+func NewHandler(logger Logger, next http.Handler, params ...HandlerParam) http.Handler {
+	h := &handler{
+		logger:       logger,
+		next:         next,
+		idsExtractor: extractor.NewDefaultIDsExtractor(),
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(h)
+	}
+	return h
+}
+
+type handler struct {
+	logger            Logger
+	next              http.Handler
+	routeInfoResolver RouteInfoResolver
+	idsExtractor      extractor.IDsFromRequest
+
+	svc1Logger   svc1log.Logger
+	evt2Logger   evt2log.Logger
+	audit2Logger audit2log.Logger
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	start := time.Now()
+
+	req = h.requestWithContextLoggers(req)
+	var body *countingReadCloser
+	if req.ContentLength == -1 && req.Body != nil && req.Body != http.NoBody {
+		body = &countingReadCloser{ReadCloser: req.Body}
+		req.Body = body
+	}
+	rw := NewResponseWriter(w)
+
+	panicked := true
+	defer func() {
+		// the request is logged even if next panics, in which case the panic continues once the entry is logged
+		h.logRequest(req, body, rw, start, panicked)
+	}()
+	h.next.ServeHTTP(rw, req)
+	panicked = false
+}
+
+func (h *handler) logRequest(req *http.Request, body *countingReadCloser, rw ResponseWriter, start time.Time, panicked bool) {
+	logReq := *req
+	if body != nil {
+		logReq.ContentLength = body.Size()
+	}
+	status := rw.Status()
+	switch {
+	case panicked:
+		// the server does not complete the response if the handler panics
+		status = http.StatusInternalServerError
+	case status == 0:
+		// the server writes a http.StatusOK response if the handler does not write anything
+		status = http.StatusOK
+	}
+	var routeInfo RouteInfo
+	if h.routeInfoResolver != nil {
+		routeInfo = h.routeInfoResolver(&logReq)
+	}
+	respHeader, respTrailer := splitResponseTrailers(rw.Header())
+	h.logger.Request(Request{
+		Request:         &logReq,
+		RouteInfo:       routeInfo,
+		ResponseStatus:  status,
+		ResponseSize:    rw.Size(),
+		Duration:        time.Since(start),
+		ResponseHeader:  respHeader,
+		ResponseTrailer: respTrailer,
+	})
+}
+
+// splitResponseTrailers splits the header map of a server response into its headers and its trailers. Trailers are
+// either declared in the "Trailer" header or set using keys prefixed with http.TrailerPrefix.
+func splitResponseTrailers(h http.Header) (header, trailer http.Header) {
+	declared := make(map[string]struct{})
+	for _, v := range h.Values("Trailer") {
+		for _, k := range strings.Split(v, ",") {
+			if k = strings.TrimSpace(k); k != "" {
+				declared[http.CanonicalHeaderKey(k)] = struct{}{}
+			}
+		}
+	}
+	header = make(http.Header, len(h))
+	for k, v := range h {
+		if strings.HasPrefix(k, http.TrailerPrefix) {
+			if trailer == nil {
+				trailer = make(http.Header)
+			}
+			trailer[http.CanonicalHeaderKey(strings.TrimPrefix(k, http.TrailerPrefix))] = v
+			continue
+		}
+		if _, ok := declared[k]; ok {
+			if trailer == nil {
+				trailer = make(http.Header)
+			}
+			trailer[k] = v
+			continue
+		}
+		header[k] = v
+	}
+	return header, trailer
+}
+
+// requestWithContextLoggers returns a shallow copy of req whose context has the configured loggers set on it. A copy is
+// returned even if no loggers are configured so that its body can be replaced without modifying req.
+func (h *handler) requestWithContextLoggers(req *http.Request) *http.Request {
+	if h.svc1Logger == nil && h.evt2Logger == nil && h.audit2Logger == nil {
+		return req.WithContext(req.Context())
+	}
+	ids := h.idsExtractor.ExtractIDs(req)
+	uid, sid, traceID := ids[extractor.UIDKey], ids[extractor.SIDKey], ids[extractor.TraceIDKey]
+
+	ctx := req.Context()
+	if h.svc1Logger != nil {
+		ctx = svc1log.WithLogger(ctx, svc1log.WithParams(h.svc1Logger, svc1log.UID(uid), svc1log.SID(sid), svc1log.TraceID(traceID)))
+	}
+	if h.evt2Logger != nil {
+		ctx = evt2log.WithLogger(ctx, evt2log.WithParams(h.evt2Logger, evt2log.UID(uid), evt2log.SID(sid), evt2log.TraceID(traceID)))
+	}
+	if h.audit2Logger != nil {
+		ctx = audit2log.WithLogger(ctx, audit2log.WithParams(h.audit2Logger, audit2log.UID(uid), audit2log.SID(sid), audit2log.TraceID(traceID)))
+	}
+	return req.WithContext(ctx)
+}
+
+// countingReadCloser counts the bytes read from a request body. The body of an outgoing request may be read by the
+// transport concurrently with the response, so the count is accessed atomically.
+type countingReadCloser struct {
+	io.ReadCloser
+	size int64
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	atomic.AddInt64(&r.size, int64(n))
+	return n, err
+}
+
+func (r *countingReadCloser) Size() int64 {
+	return atomic.LoadInt64(&r.size)
+}
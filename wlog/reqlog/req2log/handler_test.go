@@ -0,0 +1,281 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		handler http.HandlerFunc
+		params  []req2log.HandlerParam
+		req     func() *http.Request
+		matcher objmatcher.MapMatcher
+	}{
+		{
+			name: "status, sizes and route template are logged",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte("hello"))
+			},
+			params: []req2log.HandlerParam{
+				req2log.HandlerRouteInfoResolver(func(req *http.Request) req2log.RouteInfo {
+					return req2log.RouteInfo{
+						Template:   "/users/{id}",
+						PathParams: map[string]string{"id": strings.TrimPrefix(req.URL.Path, "/users/")},
+					}
+				}),
+			},
+			req: func() *http.Request {
+				// body that is not a known reader type, so the request does not have a content length
+				return httptest.NewRequest(http.MethodPost, "/users/1", io.NopCloser(strings.NewReader("abcd")))
+			},
+			matcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"time":         objmatcher.NewRegExpMatcher(".+"),
+				"type":         objmatcher.NewEqualsMatcher("request.2"),
+				"method":       objmatcher.NewEqualsMatcher("POST"),
+				"protocol":     objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":         objmatcher.NewEqualsMatcher("/users/{id}"),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("201")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("4")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("5")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{"id": "1", "clientIp": "192.0.2.1"}),
+			}),
+		},
+		{
+			name:    "handler that does not write a response is logged with status 200",
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/path", nil)
+			},
+			matcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"time":         objmatcher.NewRegExpMatcher(".+"),
+				"type":         objmatcher.NewEqualsMatcher("request.2"),
+				"method":       objmatcher.NewEqualsMatcher("GET"),
+				"protocol":     objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":         objmatcher.NewEqualsMatcher("/path"),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("0")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{"clientIp": "192.0.2.1"}),
+			}),
+		},
+		{
+			name: "body written without status is logged with status 200",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, "ok")
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/path", nil)
+			},
+			matcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"time":         objmatcher.NewRegExpMatcher(".+"),
+				"type":         objmatcher.NewEqualsMatcher("request.2"),
+				"method":       objmatcher.NewEqualsMatcher("GET"),
+				"protocol":     objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":         objmatcher.NewEqualsMatcher("/path"),
+				"params":       objmatcher.NewEqualsMatcher(map[string]interface{}{"response.Content-Type": "text/plain; charset=utf-8"}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("2")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{"clientIp": "192.0.2.1"}),
+			}),
+		},
+		{
+			name: "response headers and trailers are logged",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Set-Cookie", "session=secret")
+				w.Header().Set("Trailer", "X-Checksum")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Header().Set("X-Checksum", "checksumVal")
+				w.Header().Set(http.TrailerPrefix+"X-Undeclared", "undeclaredVal")
+			},
+			req: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/path", nil)
+			},
+			matcher: objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/path"),
+				"params": objmatcher.NewEqualsMatcher(map[string]interface{}{
+					"response.Content-Type": "application/json",
+					"response.Trailer":      "X-Checksum",
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("503")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("0")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{
+					"response.X-Checksum":   "checksumVal",
+					"response.X-Undeclared": "undeclaredVal",
+					"clientIp":              "192.0.2.1",
+				}),
+			}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			h := req2log.NewHandler(newTestLogger(buf), tc.handler, tc.params...)
+			h.ServeHTTP(httptest.NewRecorder(), tc.req())
+
+			entries, err := logreader.EntriesFromContent(buf.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, 1, len(entries))
+			assert.NoError(t, tc.matcher.Matches(map[string]interface{}(entries[0])))
+		})
+	}
+}
+
+func TestHandlerLogsPanickingRequests(t *testing.T) {
+	buf := &bytes.Buffer{}
+	h := req2log.NewHandler(newTestLogger(buf), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("handler failed")
+	}))
+	assert.PanicsWithValue(t, "handler failed", func() {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/path", nil))
+	})
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "/path", entries[0]["path"])
+	assert.Equal(t, json.Number("500"), entries[0]["status"])
+}
+
+func TestHandlerSetsContextLoggers(t *testing.T) {
+	reqBuf, svcBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	h := req2log.NewHandler(
+		newTestLogger(reqBuf),
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			svc1log.FromContext(r.Context()).Info("handling request")
+		}),
+		req2log.HandlerSvc1Logger(svc1log.NewFromCreator(svcBuf, wlog.InfoLevel, wlog.NewJSONMarshalLoggerProvider().NewLeveledLogger)),
+	)
+	req := httptest.NewRequest(http.MethodGet, "/path", nil)
+	req.Header.Set("X-B3-TraceId", "0123456789abcdef")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries, err := logreader.EntriesFromContent(svcBuf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "handling request", entries[0]["message"])
+	assert.Equal(t, "0123456789abcdef", entries[0]["traceId"])
+	assert.NotContains(t, entries[0], "uid")
+}
+
+func TestNewResponseWriterPreservesInterfaces(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		w    http.ResponseWriter
+	}{
+		{name: "no optional interfaces", w: &plainWriter{ResponseWriter: httptest.NewRecorder()}},
+		{name: "Flusher", w: httptest.NewRecorder()},
+		{name: "Hijacker", w: &hijackerWriter{plainWriter{ResponseWriter: httptest.NewRecorder()}}},
+		{name: "ReaderFrom", w: &readerFromWriter{plainWriter{ResponseWriter: httptest.NewRecorder()}}},
+		{name: "Flusher and Hijacker", w: &flusherHijackerWriter{httptest.NewRecorder()}},
+		{name: "all interfaces", w: &allWriter{flusherHijackerWriter{httptest.NewRecorder()}}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rw := req2log.NewResponseWriter(tc.w)
+
+			_, wantFlusher := tc.w.(http.Flusher)
+			_, gotFlusher := rw.(http.Flusher)
+			assert.Equal(t, wantFlusher, gotFlusher, "http.Flusher")
+
+			_, wantHijacker := tc.w.(http.Hijacker)
+			_, gotHijacker := rw.(http.Hijacker)
+			assert.Equal(t, wantHijacker, gotHijacker, "http.Hijacker")
+
+			_, wantReaderFrom := tc.w.(io.ReaderFrom)
+			readerFrom, gotReaderFrom := rw.(io.ReaderFrom)
+			assert.Equal(t, wantReaderFrom, gotReaderFrom, "io.ReaderFrom")
+
+			if gotReaderFrom {
+				_, err := readerFrom.ReadFrom(strings.NewReader("abc"))
+				require.NoError(t, err)
+			} else {
+				_, err := rw.Write([]byte("abc"))
+				require.NoError(t, err)
+			}
+			assert.Equal(t, http.StatusOK, rw.Status())
+			assert.Equal(t, int64(3), rw.Size())
+			assert.Equal(t, tc.w, rw.Unwrap())
+		})
+	}
+}
+
+// plainWriter wraps a ResponseWriter such that only the methods of http.ResponseWriter are exposed.
+type plainWriter struct {
+	http.ResponseWriter
+}
+
+type hijackerWriter struct {
+	plainWriter
+}
+
+func (w *hijackerWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+type readerFromWriter struct {
+	plainWriter
+}
+
+func (w *readerFromWriter) ReadFrom(src io.Reader) (int64, error) {
+	return io.Copy(w.plainWriter, src)
+}
+
+type flusherHijackerWriter struct {
+	*httptest.ResponseRecorder
+}
+
+func (w *flusherHijackerWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+type allWriter struct {
+	flusherHijackerWriter
+}
+
+func (w *allWriter) ReadFrom(src io.Reader) (int64, error) {
+	return io.Copy(w.ResponseRecorder, src)
+}
@@ -17,6 +17,7 @@ package req2log
 import (
 	"io"
 	"net/http"
+	"net/netip"
 	"time"
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
@@ -34,9 +35,14 @@ const (
 	requestSizeKey  = "requestSize"
 	responseSizeKey = "responseSize"
 	durationKey     = "duration"
+	errorKey        = "error"
 	traceIDKey      = wlog.TraceIDKey
 )
 
+// ResponseParamPrefix is the prefix of the keys of logged response header and trailer parameters. It distinguishes
+// them from request header parameters with the same name.
+const ResponseParamPrefix = "response."
+
 // Logger creates a request log entry based on the provided information.
 type Logger interface {
 	Request(req Request)
@@ -48,6 +54,7 @@ type RequestParamPerms interface {
 	PathParamPerms() ParamPerms
 	QueryParamPerms() ParamPerms
 	HeaderParamPerms() ParamPerms
+	ResponseHeaderParamPerms() ParamPerms
 }
 
 // Request represents an HTTP request that has been (or is about to be) completed. Contains information on the request
@@ -63,12 +70,25 @@ type Request struct {
 	ResponseSize int64
 	// Duration is the total time it took to process the request.
 	Duration time.Duration
+	// TraceID is the ID of the trace that the request is part of. If empty, the trace ID extracted from the request is
+	// logged.
+	TraceID string
+	// Error is the error that caused the request to fail, if any. Its message is logged as the unsafe parameter "error".
+	// If the request also has an unsafe parameter named "error", both values are logged as a slice.
+	Error error
 	// PathParamPerms determines the path parameters that are safe and forbidden for logging.
 	PathParamPerms ParamPerms
 	// QueryParamPerms determines the query parameters that are safe and forbidden for logging.
 	QueryParamPerms ParamPerms
 	// HeaderParamPerms determines the header parameters that are safe and forbidden for logging.
 	HeaderParamPerms ParamPerms
+	// ResponseHeader contains the headers of the response. Logged with keys prefixed with ResponseParamPrefix.
+	ResponseHeader http.Header
+	// ResponseTrailer contains the trailers of the response. Logged with keys prefixed with ResponseParamPrefix.
+	ResponseTrailer http.Header
+	// ResponseHeaderParamPerms determines the response header and trailer parameters that are safe and forbidden for
+	// logging.
+	ResponseHeaderParamPerms ParamPerms
 }
 
 type RouteInfo struct {
@@ -103,6 +123,17 @@ type LoggerBuilder interface {
 
 	SafeHeaderParams(safeHeaderParams []string)
 	ForbiddenHeaderParams(forbiddenHeaderParams []string)
+
+	SafeResponseHeaderParams(safeResponseHeaderParams []string)
+	ForbiddenResponseHeaderParams(forbiddenResponseHeaderParams []string)
+
+	PathParamRules(pathParamRules []ParamRule)
+	QueryParamRules(queryParamRules []ParamRule)
+	HeaderParamRules(headerParamRules []ParamRule)
+	ResponseHeaderParamRules(responseHeaderParamRules []ParamRule)
+
+	TrustedProxies(trustedProxies []netip.Prefix)
+	ClientIPTruncation(ipv4PrefixLen, ipv6PrefixLen int)
 }
 
 type defaultLoggerBuilder struct {
@@ -117,6 +148,18 @@ type defaultLoggerBuilder struct {
 
 	safeHeaderParams      []string
 	forbiddenHeaderParams []string
+
+	safeResponseHeaderParams      []string
+	forbiddenResponseHeaderParams []string
+
+	pathParamRules           []ParamRule
+	queryParamRules          []ParamRule
+	headerParamRules         []ParamRule
+	responseHeaderParamRules []ParamRule
+
+	trustedProxies []netip.Prefix
+	ipv4PrefixLen  int
+	ipv6PrefixLen  int
 }
 
 func (b *defaultLoggerBuilder) LoggerCreator(creator wlog.LoggerCreator) {
@@ -151,13 +194,54 @@ func (b *defaultLoggerBuilder) ForbiddenHeaderParams(forbiddenHeaderParams []str
 	b.forbiddenHeaderParams = append(b.forbiddenHeaderParams, forbiddenHeaderParams...)
 }
 
+func (b *defaultLoggerBuilder) SafeResponseHeaderParams(safeResponseHeaderParams []string) {
+	b.safeResponseHeaderParams = append(b.safeResponseHeaderParams, safeResponseHeaderParams...)
+}
+
+func (b *defaultLoggerBuilder) ForbiddenResponseHeaderParams(forbiddenResponseHeaderParams []string) {
+	b.forbiddenResponseHeaderParams = append(b.forbiddenResponseHeaderParams, forbiddenResponseHeaderParams...)
+}
+
+func (b *defaultLoggerBuilder) PathParamRules(pathParamRules []ParamRule) {
+	b.pathParamRules = append(b.pathParamRules, pathParamRules...)
+}
+
+func (b *defaultLoggerBuilder) QueryParamRules(queryParamRules []ParamRule) {
+	b.queryParamRules = append(b.queryParamRules, queryParamRules...)
+}
+
+func (b *defaultLoggerBuilder) HeaderParamRules(headerParamRules []ParamRule) {
+	b.headerParamRules = append(b.headerParamRules, headerParamRules...)
+}
+
+func (b *defaultLoggerBuilder) ResponseHeaderParamRules(responseHeaderParamRules []ParamRule) {
+	b.responseHeaderParamRules = append(b.responseHeaderParamRules, responseHeaderParamRules...)
+}
+
+func (b *defaultLoggerBuilder) TrustedProxies(trustedProxies []netip.Prefix) {
+	b.trustedProxies = append(b.trustedProxies, trustedProxies...)
+}
+
+func (b *defaultLoggerBuilder) ClientIPTruncation(ipv4PrefixLen, ipv6PrefixLen int) {
+	b.ipv4PrefixLen = ipv4PrefixLen
+	b.ipv6PrefixLen = ipv6PrefixLen
+}
+
 func (b *defaultLoggerBuilder) build(w io.Writer) *defaultLogger {
 	defaultParams := DefaultRequestParamPerms()
 	return &defaultLogger{
-		logger:           b.loggerCreator(w),
-		idsExtractor:     b.idsExtractor,
-		pathParamPerms:   CombinedParamPerms(defaultParams.PathParamPerms(), NewParamPerms(b.safePathParams, b.forbiddenPathParams)),
-		queryParamPerms:  CombinedParamPerms(defaultParams.QueryParamPerms(), NewParamPerms(b.safeQueryParams, b.forbiddenQueryParams)),
-		headerParamPerms: CombinedParamPerms(defaultParams.HeaderParamPerms(), NewParamPerms(b.safeHeaderParams, b.forbiddenHeaderParams)),
+		logger: b.loggerCreator(w),
+		opts: ToParamsOptions{
+			IDsExtractor:             b.idsExtractor,
+			PathParamPerms:           CombinedParamPerms(defaultParams.PathParamPerms(), NewParamPerms(b.safePathParams, b.forbiddenPathParams), NewRuleParamPerms(b.pathParamRules...)),
+			QueryParamPerms:          CombinedParamPerms(defaultParams.QueryParamPerms(), NewParamPerms(b.safeQueryParams, b.forbiddenQueryParams), NewRuleParamPerms(b.queryParamRules...)),
+			HeaderParamPerms:         CombinedParamPerms(defaultParams.HeaderParamPerms(), NewParamPerms(b.safeHeaderParams, b.forbiddenHeaderParams), NewRuleParamPerms(b.headerParamRules...)),
+			ResponseHeaderParamPerms: CombinedParamPerms(defaultParams.ResponseHeaderParamPerms(), NewParamPerms(b.safeResponseHeaderParams, b.forbiddenResponseHeaderParams), NewRuleParamPerms(b.responseHeaderParamRules...)),
+			ClientIPResolver: &ClientIPResolver{
+				TrustedProxies: b.trustedProxies,
+				IPv4PrefixLen:  b.ipv4PrefixLen,
+				IPv6PrefixLen:  b.ipv6PrefixLen,
+			},
+		},
 	}
 }
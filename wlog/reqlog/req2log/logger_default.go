@@ -15,6 +15,7 @@
 package req2log
 
 import (
+	"regexp"
 	"strings"
 	"time"
 
@@ -23,40 +24,92 @@ import (
 )
 
 type defaultLogger struct {
-	logger       wlog.Logger
-	idsExtractor extractor.IDsFromRequest
-
-	pathParamPerms   ParamPerms
-	queryParamPerms  ParamPerms
-	headerParamPerms ParamPerms
+	logger wlog.Logger
+	opts   ToParamsOptions
 }
 
 func (l *defaultLogger) Request(r Request) {
-	l.logger.Log(ToParams(r, l.idsExtractor, l.pathParamPerms, l.queryParamPerms, l.headerParamPerms)...)
+	l.logger.Log(ToParamsWithOptions(r, l.opts)...)
 }
 
 func (l *defaultLogger) PathParamPerms() ParamPerms {
-	return l.pathParamPerms
+	return l.opts.PathParamPerms
 }
 
 func (l *defaultLogger) QueryParamPerms() ParamPerms {
-	return l.queryParamPerms
+	return l.opts.QueryParamPerms
 }
 
 func (l *defaultLogger) HeaderParamPerms() ParamPerms {
-	return l.headerParamPerms
+	return l.opts.HeaderParamPerms
+}
+
+func (l *defaultLogger) ResponseHeaderParamPerms() ParamPerms {
+	return l.opts.ResponseHeaderParamPerms
+}
+
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *defaultLogger) Close() error {
+	return wlog.Close(l.logger)
+}
+
+// ToParamsOptions configures how ToParamsWithOptions converts a Request into the params of a request.2 entry. Fields
+// that are not set are ignored.
+type ToParamsOptions struct {
+	// IDsExtractor extracts the IDs that are logged from the request.
+	IDsExtractor extractor.IDsFromRequest
+	// PathParamPerms, QueryParamPerms and HeaderParamPerms classify the path, query and header parameters of the
+	// request in addition to the permissions set on the Request.
+	PathParamPerms   ParamPerms
+	QueryParamPerms  ParamPerms
+	HeaderParamPerms ParamPerms
+	// ResponseHeaderParamPerms classifies the response header and trailer parameters in addition to the permissions set
+	// on the Request. If it is nil, the response header permissions of DefaultRequestParamPerms are used so that
+	// sensitive response headers such as Set-Cookie are not logged.
+	ResponseHeaderParamPerms ParamPerms
+	// ClientIPResolver resolves the client IP address and proxy chain of the request, which are classified as header
+	// parameters. Client IP addresses are not logged if it is nil.
+	ClientIPResolver *ClientIPResolver
 }
 
+// ToParams returns the params of a request.2 entry for the provided request. Response headers and trailers are
+// classified using the response header permissions of DefaultRequestParamPerms and the permissions set on the Request,
+// and client IP addresses are not logged; use ToParamsWithOptions to configure them.
 func ToParams(r Request, idsExtractor extractor.IDsFromRequest, pathParamPerms, queryParamPerms, headerParamPerms ParamPerms) []wlog.Param {
-	safeParams, unsafeParams := parseRequestParams(r, pathParamPerms, queryParamPerms, headerParamPerms)
+	return ToParamsWithOptions(r, ToParamsOptions{
+		IDsExtractor:     idsExtractor,
+		PathParamPerms:   pathParamPerms,
+		QueryParamPerms:  queryParamPerms,
+		HeaderParamPerms: headerParamPerms,
+	})
+}
+
+// ToParamsWithOptions returns the params of a request.2 entry for the provided request using the provided options.
+func ToParamsWithOptions(r Request, opts ToParamsOptions) []wlog.Param {
+	if opts.ResponseHeaderParamPerms == nil {
+		opts.ResponseHeaderParamPerms = DefaultRequestParamPerms().ResponseHeaderParamPerms()
+	}
+
+	// extract IDs from request
+	var idsMap map[string]string
+	if opts.IDsExtractor != nil {
+		idsMap = opts.IDsExtractor.ExtractIDs(r.Request)
+	}
+
+	safeParams, unsafeParams := parseRequestParams(r, idsMap, opts)
 
 	reqPath := r.Request.URL.Path
 	if r.RouteInfo.Template != "" {
 		reqPath = r.RouteInfo.Template
 	}
 
-	// extract IDs from request
-	idsMap := idsExtractor.ExtractIDs(r.Request)
+	traceID := idsMap[traceIDKey]
+	if r.TraceID != "" {
+		traceID = r.TraceID
+	}
 
 	return []wlog.Param{
 		wlog.StringParam(wlog.TypeKey, TypeValue),
@@ -73,29 +126,61 @@ func ToParams(r Request, idsExtractor extractor.IDsFromRequest, pathParamPerms,
 		wlog.OptionalStringParam(wlog.SIDKey, idsMap[wlog.SIDKey]),
 		wlog.OptionalStringParam(wlog.TokenIDKey, idsMap[wlog.TokenIDKey]),
 		wlog.OptionalStringParam(wlog.OrgIDKey, idsMap[wlog.OrgIDKey]),
-		wlog.OptionalStringParam(traceIDKey, idsMap[traceIDKey]),
+		wlog.OptionalStringParam(traceIDKey, traceID),
 		unsafeParams,
 	}
 }
 
-// parseRequestParams parses the path, header and query parameters. If any of the parameters are in a respective
-// "forbidden" list, they are not logged at all. Otherwise, if a parameter is whitelisted it is added to safeParams and
-// is added to unsafeParams otherwise. If a single key has multiple values, the value for that key in the returned field
-// will be a slice that contains all of the values for the key.
-func parseRequestParams(r Request, pathParamPerms, queryParamPerms, headerParamPerms ParamPerms) (safeParams wlog.Param, unsafeParams wlog.Param) {
+// parseRequestParams parses the path, header, query and response header and trailer parameters. If any of the parameters
+// are in a respective "forbidden" list, they are not logged at all. Otherwise, if a parameter is whitelisted it is added
+// to safeParams and is added to unsafeParams otherwise. Response header and trailer parameters are keyed with
+// ResponseParamPrefix. If a single key has multiple values, the value for that key in the returned field
+// will be a slice that contains all of the values for the key. If opts.ClientIPResolver is non-nil, the client IP address
+// and proxy chain of the request are classified using the header parameter permissions. The span and request IDs in ids,
+// which do not have fields in the request.2 format, are also classified using the header parameter permissions, except
+// for span IDs that consist of 16 lowercase hex characters, which are added to safeParams. If the request has an
+// error, its message is added to unsafeParams.
+func parseRequestParams(r Request, ids map[string]string, opts ToParamsOptions) (safeParams wlog.Param, unsafeParams wlog.Param) {
 	safeMap := make(map[string]interface{})
 	unsafeMap := make(map[string]interface{})
 
+	// IDs are read from headers set by the client, so only span IDs that are known to be hex IDs are always safe
+	if spanID := ids[extractor.SpanIDKey]; spanIDPattern.MatchString(spanID) {
+		safeMap[extractor.SpanIDKey] = spanID
+	} else if spanID != "" {
+		ClassifyParam("", extractor.SpanIDKey, spanID, safeMap, unsafeMap, opts.HeaderParamPerms, r.HeaderParamPerms)
+	}
+	if requestID := ids[extractor.RequestIDKey]; requestID != "" {
+		ClassifyParam("", extractor.RequestIDKey, requestID, safeMap, unsafeMap, opts.HeaderParamPerms, r.HeaderParamPerms)
+	}
 	for pathParamKey, pathParamVal := range r.RouteInfo.PathParams {
-		processKeyValPair(pathParamKey, pathParamVal, safeMap, unsafeMap, pathParamPerms, r.PathParamPerms)
+		ClassifyParam("", pathParamKey, pathParamVal, safeMap, unsafeMap, opts.PathParamPerms, r.PathParamPerms)
 	}
 	for k, valSlice := range r.Request.URL.Query() {
 		for _, v := range valSlice {
-			processKeyValPair(k, v, safeMap, unsafeMap, queryParamPerms, r.QueryParamPerms)
+			ClassifyParam("", k, v, safeMap, unsafeMap, opts.QueryParamPerms, r.QueryParamPerms)
 		}
 	}
 	for k := range r.Request.Header {
-		processKeyValPair(k, r.Request.Header.Get(k), safeMap, unsafeMap, headerParamPerms, r.HeaderParamPerms)
+		ClassifyParam("", k, r.Request.Header.Get(k), safeMap, unsafeMap, opts.HeaderParamPerms, r.HeaderParamPerms)
+	}
+	if opts.ClientIPResolver != nil {
+		if clientIP, proxyChain := opts.ClientIPResolver.Resolve(r.Request); clientIP != "" {
+			ClassifyParam("", ClientIPParamKey, clientIP, safeMap, unsafeMap, opts.HeaderParamPerms, r.HeaderParamPerms)
+			if len(proxyChain) > 0 {
+				ClassifyParam("", ProxyChainParamKey, strings.Join(proxyChain, ", "), safeMap, unsafeMap, opts.HeaderParamPerms, r.HeaderParamPerms)
+			}
+		}
+	}
+	for k := range r.ResponseHeader {
+		ClassifyParam(ResponseParamPrefix, k, r.ResponseHeader.Get(k), safeMap, unsafeMap, opts.ResponseHeaderParamPerms, r.ResponseHeaderParamPerms)
+	}
+	for k := range r.ResponseTrailer {
+		ClassifyParam(ResponseParamPrefix, k, r.ResponseTrailer.Get(k), safeMap, unsafeMap, opts.ResponseHeaderParamPerms, r.ResponseHeaderParamPerms)
+	}
+	if r.Error != nil {
+		// merged rather than set so that parameters of the request that are named "error" are not overwritten
+		addAsMultiMap(errorKey, r.Error.Error(), unsafeMap)
 	}
 	return wlog.NewParam(func(entry wlog.LogEntry) {
 			if len(safeMap) > 0 {
@@ -108,7 +193,12 @@ func parseRequestParams(r Request, pathParamPerms, queryParamPerms, headerParamP
 		})
 }
 
-func processKeyValPair(k, v string, safeDst, unsafeDst map[string]interface{}, basePerms, reqPerms ParamPerms) {
+// spanIDPattern matches the 64-bit hex span IDs used by B3 and W3C trace context headers.
+var spanIDPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// processKeyValPair adds the provided parameter to safeDst or unsafeDst based on the provided permissions. The
+// parameter is added with the key prefix+k, but permissions are determined using k.
+func ClassifyParam(prefix, k, v string, safeDst, unsafeDst map[string]interface{}, basePerms, reqPerms ParamPerms) {
 	// lowercase keys are used for lookups. Convert once here to avoid multiple unnecessary allocations.
 	// Note that, if a key is added to an output map, the original unconverted key should be added.
 	lowerK := strings.ToLower(k)
@@ -124,21 +214,43 @@ func processKeyValPair(k, v string, safeDst, unsafeDst map[string]interface{}, b
 		return
 	}
 
+	// redaction is checked after forbidden keys and before the whitelist because redaction takes precedence over
+	// the whitelist
+	if redaction := maxRedaction(lowerK, basePerms, reqPerms); redaction != NotRedacted {
+		// key is redacted and not forbidden: add redacted value to safe
+		addAsMultiMap(prefix+k, RedactValue(v, redaction), safeDst)
+		return
+	}
+
 	// iterate over whitelist in separate loop after all of the forbidden keys are processed because forbidden
 	// takes precedence over whitelist
 	if basePerms != nil && basePerms.Safe(lowerK) {
 		// key is whitelisted and not forbidden: add to safe
-		addAsMultiMap(k, v, safeDst)
+		addAsMultiMap(prefix+k, v, safeDst)
 		return
 	}
 	if reqPerms != nil && reqPerms.Safe(lowerK) {
 		// key is whitelisted and not forbidden: add to safe
-		addAsMultiMap(k, v, safeDst)
+		addAsMultiMap(prefix+k, v, safeDst)
 		return
 	}
 
 	// not in any forbidden list or whitelist: add to unsafe
-	addAsMultiMap(k, v, unsafeDst)
+	addAsMultiMap(prefix+k, v, unsafeDst)
+}
+
+// maxRedaction returns the most restrictive redaction of the provided key by basePerms and reqPerms.
+func maxRedaction(lowerK string, basePerms, reqPerms ParamPerms) Redaction {
+	redaction := NotRedacted
+	if redactor, ok := basePerms.(ParamRedactor); ok {
+		redaction = redactor.Redaction(lowerK)
+	}
+	if redactor, ok := reqPerms.(ParamRedactor); ok {
+		if reqRedaction := redactor.Redaction(lowerK); reqRedaction > redaction {
+			redaction = reqRedaction
+		}
+	}
+	return redaction
 }
 
 func addAsMultiMap(k, v string, m map[string]interface{}) {
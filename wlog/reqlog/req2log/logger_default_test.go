@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log/req2logtests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToParamsWithOptions(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/path", nil)
+	req.Header.Set("Accept", "*/*")
+	r := req2log.Request{
+		Request:        req,
+		ResponseStatus: http.StatusOK,
+		ResponseHeader: http.Header{"Content-Type": []string{"text/plain"}},
+	}
+	headerPerms := req2log.NewParamPerms([]string{"Accept"}, nil)
+
+	values := paramValues(req2log.ToParams(r, extractor.NewDefaultIDsExtractor(), nil, nil, headerPerms))
+	assert.Equal(t, map[string]interface{}{"Accept": "*/*", "response.Content-Type": "text/plain"}, values["params"])
+	assert.Nil(t, values[wlog.UnsafeParamsKey])
+
+	values = paramValues(req2log.ToParamsWithOptions(r, req2log.ToParamsOptions{
+		HeaderParamPerms:         headerPerms,
+		ResponseHeaderParamPerms: req2log.NewParamPerms([]string{"Content-Type"}, nil),
+		ClientIPResolver:         &req2log.ClientIPResolver{},
+	}))
+	assert.Equal(t, map[string]interface{}{"Accept": "*/*", "response.Content-Type": "text/plain"}, values["params"])
+	assert.Equal(t, map[string]interface{}{"clientIp": "192.0.2.1"}, values[wlog.UnsafeParamsKey])
+}
+
+func TestClassifyParam(t *testing.T) {
+	basePerms := req2log.NewParamPerms([]string{"safe", "redacted"}, []string{"forbidden"})
+	reqPerms := req2log.NewRuleParamPerms(
+		req2log.RedactedParamRule(req2log.ExactParamNames("redacted", "forbidden")),
+	)
+	safe := make(map[string]interface{})
+	unsafe := make(map[string]interface{})
+	for _, k := range []string{"safe", "redacted", "forbidden", "other"} {
+		req2log.ClassifyParam("prefix.", k, "val", safe, unsafe, basePerms, reqPerms)
+	}
+	req2log.ClassifyParam("prefix.", "Safe", "val2", safe, unsafe, nil, basePerms)
+
+	assert.Equal(t, map[string]interface{}{
+		"prefix.safe":     "val",
+		"prefix.Safe":     "val2",
+		"prefix.redacted": req2log.RedactedValue,
+	}, safe)
+	assert.Equal(t, map[string]interface{}{"prefix.other": "val"}, unsafe)
+}
+
+func TestToParams(t *testing.T) {
+	req2logtests.ToParamsTestSuite(t)
+}
+
+func paramValues(params []wlog.Param) map[string]interface{} {
+	entry := wlog.NewMapLogEntry()
+	wlog.ApplyParams(entry, params)
+	return entry.AllValues()
+}
@@ -83,6 +83,22 @@ func (c combinedParamPermsImpl) Safe(paramName string) bool {
 	return false
 }
 
+// Redaction returns the most restrictive redaction of the parameter by any of the combined ParamPerms that implement
+// ParamRedactor.
+func (c combinedParamPermsImpl) Redaction(paramName string) Redaction {
+	redaction := NotRedacted
+	for _, p := range c {
+		redactor, ok := p.(ParamRedactor)
+		if !ok {
+			continue
+		}
+		if r := redactor.Redaction(paramName); r > redaction {
+			redaction = r
+		}
+	}
+	return redaction
+}
+
 func (c combinedParamPermsImpl) Forbidden(paramName string) bool {
 	for _, p := range c {
 		if p == nil {
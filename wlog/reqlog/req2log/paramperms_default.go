@@ -55,6 +55,43 @@ var defaultRequestParamPerms RequestParamPerms = &requestParamPermsImpl{
 			"Set-Cookie2",
 		},
 	),
+	responseHeaderParamPerms: NewParamPerms(
+		[]string{
+			"Accept-Ranges",
+			"Age",
+			"Allow",
+			"Cache-Control",
+			"Connection",
+			"Content-Encoding",
+			"Content-Language",
+			"Content-Length",
+			"Content-Security-Policy",
+			"Content-Type",
+			"Date",
+			"ETag",
+			"Expires",
+			"Last-Modified",
+			"Location",
+			"Retry-After",
+			"Server",
+			"Strict-Transport-Security",
+			"Trailer",
+			"Transfer-Encoding",
+			"Vary",
+			"X-B3-TraceId",
+			"X-Content-Type-Options",
+			"X-Frame-Options",
+			"X-XSS-Protection",
+		},
+		[]string{
+			"Authorization",
+			"Cookie",
+			"Proxy-Authenticate",
+			"Set-Cookie",
+			"Set-Cookie2",
+			"WWW-Authenticate",
+		},
+	),
 }
 
 func DefaultRequestParamPerms() RequestParamPerms {
@@ -66,9 +103,10 @@ func SetRequestParamPerms(perms RequestParamPerms) {
 }
 
 type requestParamPermsImpl struct {
-	pathParamPerms   ParamPerms
-	queryParamPerms  ParamPerms
-	headerParamPerms ParamPerms
+	pathParamPerms           ParamPerms
+	queryParamPerms          ParamPerms
+	headerParamPerms         ParamPerms
+	responseHeaderParamPerms ParamPerms
 }
 
 func (r *requestParamPermsImpl) PathParamPerms() ParamPerms {
@@ -82,3 +120,7 @@ func (r *requestParamPermsImpl) QueryParamPerms() ParamPerms {
 func (r *requestParamPermsImpl) HeaderParamPerms() ParamPerms {
 	return r.headerParamPerms
 }
+
+func (r *requestParamPermsImpl) ResponseHeaderParamPerms() ParamPerms {
+	return r.responseHeaderParamPerms
+}
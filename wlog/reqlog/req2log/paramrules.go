@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+// Redaction specifies how the value of a parameter is logged.
+type Redaction int
+
+const (
+	// NotRedacted indicates that the value of the parameter is logged as-is.
+	NotRedacted Redaction = iota
+	// Hashed indicates that the value of the parameter is logged as a fingerprint of the value. The fingerprint is the
+	// first 16 hexadecimal characters of the SHA-256 hash of the value prefixed with "sha256:". It allows the values of
+	// parameters to be compared across entries, but should not be relied on to protect values that are easy to guess.
+	Hashed
+	// Redacted indicates that the value of the parameter is logged as RedactedValue.
+	Redacted
+)
+
+// RedactedValue is the value that is logged for parameters whose values are Redacted.
+const RedactedValue = "REDACTED"
+
+// ParamRedactor is implemented by ParamPerms that log the values of some parameters in redacted form. Parameters whose
+// values are redacted are logged as safe parameters (so their presence is visible) unless they are forbidden.
+type ParamRedactor interface {
+	// Redaction returns how the value of the parameter with the provided name is redacted. Case-insensitive.
+	Redaction(paramName string) Redaction
+}
+
+// RedactValue returns the value that is logged for a parameter with the provided value and redaction.
+func RedactValue(value string, redaction Redaction) string {
+	switch redaction {
+	case Hashed:
+		hash := sha256.Sum256([]byte(value))
+		return "sha256:" + hex.EncodeToString(hash[:8])
+	case Redacted:
+		return RedactedValue
+	default:
+		return value
+	}
+}
+
+// ParamMatcher returns true if the provided lowercase parameter name matches.
+type ParamMatcher func(lowerParamName string) bool
+
+// ExactParamNames returns a ParamMatcher that matches the provided names. Case-insensitive.
+func ExactParamNames(names ...string) ParamMatcher {
+	nameSet := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		nameSet[strings.ToLower(name)] = struct{}{}
+	}
+	return func(lowerParamName string) bool {
+		_, ok := nameSet[lowerParamName]
+		return ok
+	}
+}
+
+// GlobParamNames returns a ParamMatcher that matches names that match any of the provided patterns. In a pattern, '*'
+// matches any sequence of characters (including the empty sequence) and '?' matches any single character. All other
+// characters match themselves. Case-insensitive.
+func GlobParamNames(patterns ...string) ParamMatcher {
+	lowerPatterns := make([]string, len(patterns))
+	for i, pattern := range patterns {
+		lowerPatterns[i] = strings.ToLower(pattern)
+	}
+	return func(lowerParamName string) bool {
+		for _, pattern := range lowerPatterns {
+			if globMatch(pattern, lowerParamName) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RegexpParamNames returns a ParamMatcher that matches names that match any of the provided regular expressions. The
+// expressions are matched against the lowercase name of the parameter and are not anchored unless they specify anchors.
+func RegexpParamNames(regexps ...*regexp.Regexp) ParamMatcher {
+	return func(lowerParamName string) bool {
+		for _, re := range regexps {
+			if re.MatchString(lowerParamName) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// paramOutcome is the way in which a parameter is logged. Outcomes are ordered by precedence: if a parameter matches
+// multiple rules, the outcome with the highest value is used.
+type paramOutcome int
+
+const (
+	unsafeOutcome paramOutcome = iota
+	safeOutcome
+	hashedOutcome
+	redactedOutcome
+	forbiddenOutcome
+)
+
+// ParamRule determines how the parameters whose names match it are logged. Rules are created using SafeParamRule,
+// ForbiddenParamRule, HashedParamRule and RedactedParamRule.
+type ParamRule struct {
+	matcher ParamMatcher
+	outcome paramOutcome
+}
+
+// SafeParamRule returns a rule that marks matching parameters as safe.
+func SafeParamRule(matcher ParamMatcher) ParamRule {
+	return ParamRule{matcher: matcher, outcome: safeOutcome}
+}
+
+// ForbiddenParamRule returns a rule that marks matching parameters as forbidden.
+func ForbiddenParamRule(matcher ParamMatcher) ParamRule {
+	return ParamRule{matcher: matcher, outcome: forbiddenOutcome}
+}
+
+// HashedParamRule returns a rule that logs the values of matching parameters as Hashed.
+func HashedParamRule(matcher ParamMatcher) ParamRule {
+	return ParamRule{matcher: matcher, outcome: hashedOutcome}
+}
+
+// RedactedParamRule returns a rule that logs the values of matching parameters as Redacted.
+func RedactedParamRule(matcher ParamMatcher) ParamRule {
+	return ParamRule{matcher: matcher, outcome: redactedOutcome}
+}
+
+type ruleParamPermsImpl []ParamRule
+
+// NewRuleParamPerms returns ParamPerms that classify parameters using the provided rules. If a parameter matches more
+// than one rule, the most restrictive rule applies: forbidden takes precedence over redacted, redacted over hashed and
+// hashed over safe. The returned ParamPerms implement ParamRedactor.
+func NewRuleParamPerms(rules ...ParamRule) ParamPerms {
+	return ruleParamPermsImpl(rules)
+}
+
+func (r ruleParamPermsImpl) Safe(paramName string) bool {
+	return r.outcome(paramName) == safeOutcome
+}
+
+func (r ruleParamPermsImpl) Forbidden(paramName string) bool {
+	return r.outcome(paramName) == forbiddenOutcome
+}
+
+func (r ruleParamPermsImpl) Redaction(paramName string) Redaction {
+	switch r.outcome(paramName) {
+	case hashedOutcome:
+		return Hashed
+	case redactedOutcome:
+		return Redacted
+	default:
+		return NotRedacted
+	}
+}
+
+func (r ruleParamPermsImpl) outcome(paramName string) paramOutcome {
+	lowerParamName := strings.ToLower(paramName)
+	outcome := unsafeOutcome
+	for _, rule := range r {
+		if rule.outcome > outcome && rule.matcher != nil && rule.matcher(lowerParamName) {
+			outcome = rule.outcome
+		}
+	}
+	return outcome
+}
+
+// globMatch returns true if name matches pattern, where '*' in pattern matches any sequence of characters and '?'
+// matches any single character.
+func globMatch(pattern, name string) bool {
+	// position in pattern and name to resume from when backtracking to the most recent '*'
+	starIdx, matchIdx := -1, 0
+	p, n := 0, 0
+	for n < len(name) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == name[n]):
+			p++
+			n++
+		case p < len(pattern) && pattern[p] == '*':
+			starIdx, matchIdx = p, n
+			p++
+		case starIdx != -1:
+			// extend the sequence matched by the most recent '*' by one character
+			matchIdx++
+			p, n = starIdx+1, matchIdx
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
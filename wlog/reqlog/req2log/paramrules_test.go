@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log_test
+
+import (
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlobParamNames(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{pattern: "x-ourco-*", name: "X-OurCo-Request", want: true},
+		{pattern: "x-ourco-*", name: "x-ourco-", want: true},
+		{pattern: "x-ourco-*", name: "x-other-request", want: false},
+		{pattern: "*token*", name: "X-Auth-Token-Id", want: true},
+		{pattern: "*token*", name: "token", want: true},
+		{pattern: "*token*", name: "tokes", want: false},
+		{pattern: "a*b*c", name: "aXbYbZc", want: true},
+		{pattern: "a*b*c", name: "aXbYcZ", want: false},
+		{pattern: "x-?", name: "x-1", want: true},
+		{pattern: "x-?", name: "x-12", want: false},
+		{pattern: "exact", name: "Exact", want: true},
+		{pattern: "", name: "", want: true},
+		{pattern: "*", name: "", want: true},
+	} {
+		got := req2log.NewRuleParamPerms(req2log.SafeParamRule(req2log.GlobParamNames(tc.pattern))).Safe(tc.name)
+		assert.Equal(t, tc.want, got, "pattern %q, name %q", tc.pattern, tc.name)
+	}
+}
+
+func TestRuleParamPermsPrecedence(t *testing.T) {
+	perms := req2log.CombinedParamPerms(
+		req2log.NewParamPerms([]string{"X-Api-Key", "X-Session-Token"}, nil),
+		req2log.NewRuleParamPerms(
+			req2log.SafeParamRule(req2log.GlobParamNames("x-*")),
+			req2log.HashedParamRule(req2log.GlobParamNames("*-key")),
+			req2log.RedactedParamRule(req2log.GlobParamNames("*token*")),
+			req2log.HashedParamRule(req2log.GlobParamNames("*session*")),
+			req2log.ForbiddenParamRule(req2log.ExactParamNames("X-Secret-Key")),
+		),
+	)
+	redactor, ok := perms.(req2log.ParamRedactor)
+	if !assert.True(t, ok, "combined ParamPerms should implement ParamRedactor") {
+		return
+	}
+
+	assert.True(t, perms.Safe("x-request-id"))
+	assert.Equal(t, req2log.NotRedacted, redactor.Redaction("x-request-id"))
+
+	assert.Equal(t, req2log.Hashed, redactor.Redaction("x-api-key"))
+	assert.Equal(t, req2log.Redacted, redactor.Redaction("x-session-token"))
+
+	assert.True(t, perms.Forbidden("x-secret-key"))
+	assert.False(t, perms.Safe("x-secret-key"))
+
+	assert.Equal(t, "sha256:4de4581cb7ed1ba3", req2log.RedactValue("fooHeaderParamVal", req2log.Hashed))
+	assert.Equal(t, req2log.RedactedValue, req2log.RedactValue("fooHeaderParamVal", req2log.Redacted))
+	assert.Equal(t, "fooHeaderParamVal", req2log.RedactValue("fooHeaderParamVal", req2log.NotRedacted))
+}
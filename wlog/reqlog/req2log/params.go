@@ -15,6 +15,8 @@
 package req2log
 
 import (
+	"net/netip"
+
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
 )
@@ -76,3 +78,66 @@ func ForbiddenHeaderParams(forbiddenParams ...string) LoggerCreatorParam {
 		builder.ForbiddenHeaderParams(forbiddenParams)
 	})
 }
+
+// SafeResponseHeaderParams configures the logger to treat the provided response header and trailer parameters as safe.
+func SafeResponseHeaderParams(safeParams ...string) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.SafeResponseHeaderParams(safeParams)
+	})
+}
+
+// ForbiddenResponseHeaderParams configures the logger to never log the provided response header and trailer
+// parameters.
+func ForbiddenResponseHeaderParams(forbiddenParams ...string) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.ForbiddenResponseHeaderParams(forbiddenParams)
+	})
+}
+
+// PathParamRules configures the logger to classify path parameters using the provided rules in addition to the safe
+// and forbidden path parameters.
+func PathParamRules(rules ...ParamRule) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.PathParamRules(rules)
+	})
+}
+
+// QueryParamRules configures the logger to classify query parameters using the provided rules in addition to the safe
+// and forbidden query parameters.
+func QueryParamRules(rules ...ParamRule) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.QueryParamRules(rules)
+	})
+}
+
+// HeaderParamRules configures the logger to classify header parameters using the provided rules in addition to the
+// safe and forbidden header parameters.
+func HeaderParamRules(rules ...ParamRule) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.HeaderParamRules(rules)
+	})
+}
+
+// ResponseHeaderParamRules configures the logger to classify response header and trailer parameters using the provided
+// rules in addition to the safe and forbidden response header parameters.
+func ResponseHeaderParamRules(rules ...ParamRule) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.ResponseHeaderParamRules(rules)
+	})
+}
+
+// TrustedProxies configures the logger to trust the "Forwarded" and "X-Forwarded-For" headers set by proxies in the
+// provided networks when resolving the client IP address of requests.
+func TrustedProxies(trustedProxies ...netip.Prefix) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.TrustedProxies(trustedProxies)
+	})
+}
+
+// ClientIPTruncation configures the logger to truncate client IP addresses to the provided number of bits, such as 24
+// for IPv4 and 48 for IPv6. A value of 0 disables truncation for the respective address family.
+func ClientIPTruncation(ipv4PrefixLen, ipv6PrefixLen int) LoggerCreatorParam {
+	return loggerCreatorParamFunc(func(builder LoggerBuilder) {
+		builder.ClientIPTruncation(ipv4PrefixLen, ipv6PrefixLen)
+	})
+}
@@ -19,12 +19,16 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
 	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/stretchr/testify/assert"
@@ -32,13 +36,24 @@ import (
 )
 
 type TestCase struct {
-	Name                  string
-	ExtraHeaderParams     map[string]string
-	ExtraQueryParams      []string
-	SafeHeaderParams      []string
-	SafeQueryParams       []string
-	ForbiddenHeaderParams []string
-	JSONMatcher           objmatcher.MapMatcher
+	Name                          string
+	ExtraHeaderParams             map[string]string
+	ExtraQueryParams              []string
+	SafeHeaderParams              []string
+	SafeQueryParams               []string
+	ForbiddenHeaderParams         []string
+	QueryParamRules               []req2log.ParamRule
+	HeaderParamRules              []req2log.ParamRule
+	ResponseHeader                http.Header
+	ResponseTrailer               http.Header
+	SafeResponseHeaderParams      []string
+	ForbiddenResponseHeaderParams []string
+	RemoteAddr                    string
+	TrustedProxies                []netip.Prefix
+	IPv4PrefixLen                 int
+	IPv6PrefixLen                 int
+	IDsExtractor                  extractor.IDsFromRequest
+	JSONMatcher                   objmatcher.MapMatcher
 }
 
 func TestCases() []TestCase {
@@ -200,9 +215,222 @@ func TestCases() []TestCase {
 				}),
 			},
 		},
+		{
+			Name:             "request.2 log entry with glob rules",
+			HeaderParamRules: []req2log.ParamRule{req2log.SafeParamRule(req2log.GlobParamNames("foo*"))},
+			QueryParamRules:  []req2log.ParamRule{req2log.ForbiddenParamRule(req2log.GlobParamNames("*BAR*"))},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"fooQueryVarName": objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+				}),
+			},
+		},
+		{
+			Name:             "request.2 log entry with hashed and redacted rules",
+			SafeHeaderParams: []string{"Fooheaderparamname"},
+			HeaderParamRules: []req2log.ParamRule{req2log.HashedParamRule(req2log.ExactParamNames("FooHeaderParamName"))},
+			QueryParamRules:  []req2log.ParamRule{req2log.RedactedParamRule(req2log.RegexpParamNames(regexp.MustCompile("^foo")))},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"Fooheaderparamname": objmatcher.NewEqualsMatcher("sha256:4de4581cb7ed1ba3"),
+					"fooQueryVarName":    objmatcher.NewEqualsMatcher("REDACTED"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"barQueryVarName": objmatcher.NewEqualsMatcher("barQueryVarVal"),
+				}),
+			},
+		},
+		{
+			Name: "request.2 log entry with response headers and trailers",
+			ResponseHeader: http.Header{
+				"Content-Type":       []string{"application/json"},
+				"Retry-After":        []string{"120"},
+				"Set-Cookie":         []string{"session=secret"},
+				"X-Error-Code":       []string{"Default:Timeout"},
+				"Fooheaderparamname": []string{"fooResponseHeaderVal"},
+			},
+			ResponseTrailer: http.Header{
+				"X-Checksum": []string{"checksumVal"},
+			},
+			SafeResponseHeaderParams:      []string{"X-Error-Code"},
+			ForbiddenResponseHeaderParams: []string{"X-Checksum"},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"response.Content-Type": objmatcher.NewEqualsMatcher("application/json"),
+					"response.Retry-After":  objmatcher.NewEqualsMatcher("120"),
+					"response.X-Error-Code": objmatcher.NewEqualsMatcher("Default:Timeout"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"fooQueryVarName":             objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+					"barQueryVarName":             objmatcher.NewEqualsMatcher("barQueryVarVal"),
+					"Fooheaderparamname":          objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+					"response.Fooheaderparamname": objmatcher.NewEqualsMatcher("fooResponseHeaderVal"),
+				}),
+			},
+		},
+		{
+			Name:              "request.2 log entry with client IP resolved through trusted proxy",
+			ExtraHeaderParams: map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7"},
+			SafeHeaderParams:  []string{req2log.ClientIPParamKey},
+			RemoteAddr:        "10.0.0.1:52000",
+			TrustedProxies:    []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			IPv4PrefixLen:     24,
+			IPv6PrefixLen:     48,
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"clientIp": objmatcher.NewEqualsMatcher("203.0.113.0/24"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"fooQueryVarName":    objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+					"barQueryVarName":    objmatcher.NewEqualsMatcher("barQueryVarVal"),
+					"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+					"X-Forwarded-For":    objmatcher.NewEqualsMatcher("198.51.100.1, 203.0.113.7"),
+					"proxyChain":         objmatcher.NewEqualsMatcher("10.0.0.1"),
+				}),
+			},
+		},
+		{
+			Name:              "request.2 log entry with span and request IDs from custom extractor",
+			ExtraHeaderParams: map[string]string{"X-B3-SpanId": "e457b5a2e4d86bd1", "X-Request-Id": "request-1"},
+			IDsExtractor:      extractor.NewCompoundExtractor(extractor.NewDefaultIDsExtractor(), extractor.NewB3MultiHeaderExtractor(), extractor.NewRequestIDExtractor()),
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"X-B3-Spanid": objmatcher.NewEqualsMatcher("e457b5a2e4d86bd1"),
+					"spanId":      objmatcher.NewEqualsMatcher("e457b5a2e4d86bd1"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"fooQueryVarName":    objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+					"barQueryVarName":    objmatcher.NewEqualsMatcher("barQueryVarVal"),
+					"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+					"X-Request-Id":       objmatcher.NewEqualsMatcher("request-1"),
+					"requestId":          objmatcher.NewEqualsMatcher("request-1"),
+				}),
+			},
+		},
+		{
+			Name:              "request.2 log entry with span and request IDs classified using header params",
+			ExtraHeaderParams: map[string]string{"X-B3-SpanId": "not-a-span-id", "X-Request-Id": "request-1"},
+			SafeHeaderParams:  []string{"requestId"},
+			IDsExtractor:      extractor.NewCompoundExtractor(extractor.NewDefaultIDsExtractor(), extractor.NewB3MultiHeaderExtractor(), extractor.NewRequestIDExtractor()),
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":     objmatcher.NewEqualsMatcher("request.2"),
+				"time":     objmatcher.NewRegExpMatcher(".+"),
+				"method":   objmatcher.NewEqualsMatcher("GET"),
+				"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+				"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+				"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"X-B3-Spanid": objmatcher.NewEqualsMatcher("not-a-span-id"),
+					"requestId":   objmatcher.NewEqualsMatcher("request-1"),
+				}),
+				"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+				"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+				"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+				"duration":     objmatcher.NewAnyMatcher(),
+				"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+				"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+				"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+				"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+				"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"fooQueryVarName":    objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+					"barQueryVarName":    objmatcher.NewEqualsMatcher("barQueryVarVal"),
+					"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+					"X-Request-Id":       objmatcher.NewEqualsMatcher("request-1"),
+					"spanId":             objmatcher.NewEqualsMatcher("not-a-span-id"),
+				}),
+			},
+		},
 	}
 }
 
+// ToParamsTestSuite verifies the params returned by req2log.ToParams.
+func ToParamsTestSuite(t *testing.T) {
+	t.Run("response headers classified using default permissions", func(t *testing.T) {
+		req := GenerateRequest(nil, nil, nil)
+		params := req2log.ToParams(req2log.Request{
+			Request:        req,
+			ResponseStatus: http.StatusOK,
+			ResponseHeader: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Set-Cookie":   []string{"session=secret"},
+			},
+		}, extractor.NewDefaultIDsExtractor(), nil, nil, nil)
+
+		entry := wlog.NewMapLogEntry()
+		wlog.ApplyParams(entry, params)
+		values := entry.AllValues()
+		assert.Equal(t, map[string]interface{}{"response.Content-Type": "application/json"}, values["params"])
+		assert.NotContains(t, values[wlog.UnsafeParamsKey], "response.Set-Cookie")
+	})
+}
+
 func JSONTestSuite(t *testing.T, loggerProvider func(w io.Writer, params ...req2log.LoggerCreatorParam) req2log.Logger) {
 	jsonOutputTests(t, loggerProvider)
 }
@@ -217,18 +445,30 @@ func jsonOutputTests(t *testing.T, loggerProvider func(w io.Writer, params ...re
 			}, tc.ExtraQueryParams, tc.ExtraHeaderParams)
 
 			buf := &bytes.Buffer{}
-			logger := loggerProvider(
-				buf,
+			params := []req2log.LoggerCreatorParam{
 				req2log.SafeQueryParams(tc.SafeQueryParams...),
 				req2log.SafeHeaderParams(tc.SafeHeaderParams...),
 				req2log.ForbiddenHeaderParams(tc.ForbiddenHeaderParams...),
-			)
+				req2log.QueryParamRules(tc.QueryParamRules...),
+				req2log.HeaderParamRules(tc.HeaderParamRules...),
+				req2log.SafeResponseHeaderParams(tc.SafeResponseHeaderParams...),
+				req2log.ForbiddenResponseHeaderParams(tc.ForbiddenResponseHeaderParams...),
+				req2log.TrustedProxies(tc.TrustedProxies...),
+				req2log.ClientIPTruncation(tc.IPv4PrefixLen, tc.IPv6PrefixLen),
+			}
+			if tc.IDsExtractor != nil {
+				params = append(params, req2log.Extractor(tc.IDsExtractor))
+			}
+			logger := loggerProvider(buf, params...)
+			req.RemoteAddr = tc.RemoteAddr
 			logger.Request(req2log.Request{
-				Request:        req,
-				RouteInfo:      req2log.RouteInfo{},
-				ResponseStatus: http.StatusOK,
-				ResponseSize:   int64(100),
-				Duration:       1 * time.Second,
+				Request:         req,
+				RouteInfo:       req2log.RouteInfo{},
+				ResponseStatus:  http.StatusOK,
+				ResponseSize:    int64(100),
+				Duration:        1 * time.Second,
+				ResponseHeader:  tc.ResponseHeader,
+				ResponseTrailer: tc.ResponseTrailer,
 			})
 
 			entries, err := logreader.EntriesFromContent(buf.Bytes())
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"net/http"
+)
+
+// ResponseWriter is an http.ResponseWriter that records the status code and the number of bytes written in the
+// response.
+type ResponseWriter interface {
+	http.ResponseWriter
+
+	// Status returns the status code of the response. Returns http.StatusOK if a body was written without calling
+	// WriteHeader and 0 if nothing has been written.
+	Status() int
+	// Size returns the number of bytes of the response body that have been written.
+	Size() int64
+	// Unwrap returns the wrapped http.ResponseWriter. Used by http.ResponseController.
+	Unwrap() http.ResponseWriter
+}
+
+// NewResponseWriter returns a ResponseWriter that wraps w. The returned writer implements http.Flusher, http.Hijacker
+// and io.ReaderFrom if and only if w implements them so that handlers that check for these interfaces behave the same
+// way as they would without the wrapper.
+func NewResponseWriter(w http.ResponseWriter) ResponseWriter {
+	rw := &responseWriter{ResponseWriter: w}
+	flusher, isFlusher := w.(http.Flusher)
+	hijacker, isHijacker := w.(http.Hijacker)
+	readerFrom, isReaderFrom := w.(io.ReaderFrom)
+
+	switch {
+	case isFlusher && isHijacker && isReaderFrom:
+		return &struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+			io.ReaderFrom
+		}{rw, &flushWriter{rw, flusher}, &hijackWriter{rw, hijacker}, &readerFromWriter{rw, readerFrom}}
+	case isFlusher && isHijacker:
+		return &struct {
+			*responseWriter
+			http.Flusher
+			http.Hijacker
+		}{rw, &flushWriter{rw, flusher}, &hijackWriter{rw, hijacker}}
+	case isFlusher && isReaderFrom:
+		return &struct {
+			*responseWriter
+			http.Flusher
+			io.ReaderFrom
+		}{rw, &flushWriter{rw, flusher}, &readerFromWriter{rw, readerFrom}}
+	case isHijacker && isReaderFrom:
+		return &struct {
+			*responseWriter
+			http.Hijacker
+			io.ReaderFrom
+		}{rw, &hijackWriter{rw, hijacker}, &readerFromWriter{rw, readerFrom}}
+	case isFlusher:
+		return &struct {
+			*responseWriter
+			http.Flusher
+		}{rw, &flushWriter{rw, flusher}}
+	case isHijacker:
+		return &struct {
+			*responseWriter
+			http.Hijacker
+		}{rw, &hijackWriter{rw, hijacker}}
+	case isReaderFrom:
+		return &struct {
+			*responseWriter
+			io.ReaderFrom
+		}{rw, &readerFromWriter{rw, readerFrom}}
+	default:
+		return rw
+	}
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	// informational responses may be followed by another call to WriteHeader, so only the final status is recorded
+	if w.status == 0 && (code < 100 || code >= 200) {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.wroteBody()
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *responseWriter) Status() int {
+	return w.status
+}
+
+func (w *responseWriter) Size() int64 {
+	return w.size
+}
+
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// wroteBody records that the response body is being written, which implicitly writes a http.StatusOK header if
+// WriteHeader has not been called.
+func (w *responseWriter) wroteBody() {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+}
+
+type flushWriter struct {
+	rw      *responseWriter
+	flusher http.Flusher
+}
+
+func (w *flushWriter) Flush() {
+	w.rw.wroteBody()
+	w.flusher.Flush()
+}
+
+type hijackWriter struct {
+	rw       *responseWriter
+	hijacker http.Hijacker
+}
+
+func (w *hijackWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, buf, err := w.hijacker.Hijack()
+	if err == nil && w.rw.status == 0 {
+		// the connection is no longer managed by the server, so the response is considered to switch protocols
+		w.rw.status = http.StatusSwitchingProtocols
+	}
+	return conn, buf, err
+}
+
+type readerFromWriter struct {
+	rw         *responseWriter
+	readerFrom io.ReaderFrom
+}
+
+func (w *readerFromWriter) ReadFrom(src io.Reader) (int64, error) {
+	w.rw.wroteBody()
+	n, err := w.readerFrom.ReadFrom(src)
+	w.rw.size += n
+	return n, err
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+)
+
+type RoundTripperParam interface {
+	apply(rt *roundTripper)
+}
+
+type roundTripperParamFunc func(rt *roundTripper)
+
+func (f roundTripperParamFunc) apply(rt *roundTripper) {
+	f(rt)
+}
+
+// RoundTripperRouteInfoResolver configures the round tripper to use the provided resolver to determine the path
+// template and path parameters of outgoing requests. If no resolver is provided, entries are logged with the path of
+// the request URL and no path parameters.
+func RoundTripperRouteInfoResolver(resolver RouteInfoResolver) RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		rt.routeInfoResolver = resolver
+	})
+}
+
+// RoundTripperQueryParamPerms configures the permissions of query parameters in addition to the query parameter
+// permissions of the logger.
+func RoundTripperQueryParamPerms(perms ParamPerms) RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		rt.queryParamPerms = perms
+	})
+}
+
+// RoundTripperHeaderParamPerms configures the permissions of header parameters in addition to the header parameter
+// permissions of the logger.
+func RoundTripperHeaderParamPerms(perms ParamPerms) RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		rt.headerParamPerms = perms
+	})
+}
+
+// RoundTripperResponseHeaderParamPerms configures the permissions of response header and trailer parameters in addition
+// to the response header parameter permissions of the logger.
+func RoundTripperResponseHeaderParamPerms(perms ParamPerms) RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		rt.responseHeaderParamPerms = perms
+	})
+}
+
+// RoundTripperTraceIDFromContext configures the round tripper to log the traceId of the span stored in the context of
+// the outgoing request.
+func RoundTripperTraceIDFromContext() RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		rt.traceIDFromContext = true
+	})
+}
+
+// RoundTripperTrc1Logger configures the round tripper to log a client span for every outgoing request using the
+// provided logger. The span is a child of the span stored in the context of the request, if any.
+func RoundTripperTrc1Logger(logger trc1log.Logger) RoundTripperParam {
+	return roundTripperParamFunc(func(rt *roundTripper) {
+		// NewTracer only returns an error if the provided tracer options are invalid, and no options are provided
+		rt.tracer, _ = wzipkin.NewTracer(logger)
+	})
+}
+
+// NewRoundTripper returns an http.RoundTripper that sends requests using base and logs a request.2 entry for every
+// request using the provided logger. If base is nil, http.DefaultTransport is used.
+//
+// Entries for requests that receive a response are logged once the body of the response has been read to completion or
+// closed, so the logged duration and response size cover the entire response. As with any http.RoundTripper, callers
+// must close the body of every response: if the body of a response is neither read to completion nor closed, no entry
+// is logged for the request and its client span (see RoundTripperTrc1Logger) is never finished or logged. Entries for
+// requests that fail are logged immediately with the error as an unsafe parameter. The host of every request is logged
+// as its "Host" header.
+func NewRoundTripper(logger Logger, base http.RoundTripper, params ...RoundTripperParam) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	rt := &roundTripper{
+		logger: logger,
+		base:   base,
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(rt)
+	}
+	return rt
+}
+
+type roundTripper struct {
+	logger                   Logger
+	base                     http.RoundTripper
+	routeInfoResolver        RouteInfoResolver
+	queryParamPerms          ParamPerms
+	headerParamPerms         ParamPerms
+	responseHeaderParamPerms ParamPerms
+	traceIDFromContext       bool
+	tracer                   wtracing.Tracer
+}
+
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	var routeInfo RouteInfo
+	if rt.routeInfoResolver != nil {
+		routeInfo = rt.routeInfoResolver(req)
+	}
+
+	ctx := req.Context()
+	var span wtracing.Span
+	if rt.tracer != nil {
+		spanName := req.URL.Path
+		if routeInfo.Template != "" {
+			spanName = routeInfo.Template
+		}
+		span, ctx = wtracing.StartSpanFromContext(ctx, rt.tracer, req.Method+" "+spanName, wtracing.WithKind(wtracing.Client))
+	}
+
+	// round trippers must not modify the provided request, so the body is replaced on a copy
+	outReq := req.WithContext(ctx)
+	var body *countingReadCloser
+	// the length of the body of an outgoing request is unknown if ContentLength is 0 and Body is not empty
+	if outReq.ContentLength <= 0 && outReq.Body != nil && outReq.Body != http.NoBody {
+		body = &countingReadCloser{ReadCloser: outReq.Body}
+		outReq.Body = body
+	}
+
+	logRequest := func(resp *http.Response, respSize int64, err error) {
+		if span != nil {
+			if resp != nil {
+				span.Tag("http.status_code", strconv.Itoa(resp.StatusCode))
+			}
+			if err != nil {
+				span.Tag("error", err.Error())
+			}
+			span.Finish()
+		}
+		r := Request{
+			Request:                  rt.loggedRequest(outReq, resp, body),
+			RouteInfo:                routeInfo,
+			ResponseSize:             respSize,
+			Duration:                 time.Since(start),
+			QueryParamPerms:          rt.queryParamPerms,
+			HeaderParamPerms:         rt.headerParamPerms,
+			ResponseHeaderParamPerms: rt.responseHeaderParamPerms,
+			Error:                    err,
+		}
+		if resp != nil {
+			r.ResponseStatus = resp.StatusCode
+			// trailers are only populated once the body of the response has been read to completion
+			r.ResponseHeader = resp.Header
+			r.ResponseTrailer = resp.Trailer
+		}
+		if rt.traceIDFromContext {
+			r.TraceID = string(wtracing.TraceIDFromContext(ctx))
+		}
+		rt.logger.Request(r)
+	}
+
+	resp, err := rt.base.RoundTrip(outReq)
+	switch {
+	case err != nil:
+		logRequest(nil, 0, err)
+	case resp.Body == nil || resp.Body == http.NoBody || resp.StatusCode == http.StatusSwitchingProtocols:
+		// the body of a response that switches protocols is an io.ReadWriteCloser that must not be wrapped
+		logRequest(resp, 0, nil)
+	default:
+		resp.Body = &loggingResponseBody{
+			ReadCloser: resp.Body,
+			log: func(size int64, err error) {
+				logRequest(resp, size, err)
+			},
+		}
+	}
+	return resp, err
+}
+
+// loggedRequest returns a copy of the provided request that is used to create the log entry. The copy has the host of
+// the request set as its "Host" header, its content length set to the number of bytes read from the body if the
+// request did not specify it and the protocol of the response if a response was received.
+func (rt *roundTripper) loggedRequest(req *http.Request, resp *http.Response, body *countingReadCloser) *http.Request {
+	logReq := *req
+	logReq.Header = req.Header.Clone()
+	if logReq.Header == nil {
+		logReq.Header = make(http.Header)
+	}
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	logReq.Header.Set("Host", host)
+	if body != nil {
+		logReq.ContentLength = body.Size()
+	}
+	if resp != nil && resp.Proto != "" {
+		logReq.Proto = resp.Proto
+	}
+	return &logReq
+}
+
+// loggingResponseBody calls log with the number of bytes read from the body once the body has been read to completion
+// or closed, whichever happens first. The error is the error returned by the body, if it is not io.EOF.
+type loggingResponseBody struct {
+	io.ReadCloser
+	size int64
+	once sync.Once
+	log  func(size int64, err error)
+}
+
+func (b *loggingResponseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	if err == io.EOF {
+		b.done(nil)
+	} else if err != nil {
+		b.done(err)
+	}
+	return n, err
+}
+
+func (b *loggingResponseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done(nil)
+	return err
+}
+
+func (b *loggingResponseBody) done(err error) {
+	b.once.Do(func() {
+		b.log(b.size, err)
+	})
+}
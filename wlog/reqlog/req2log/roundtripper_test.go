@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package req2log_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+	"github.com/palantir/witchcraft-go-tracing/wtracing"
+	"github.com/palantir/witchcraft-go-tracing/wzipkin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	buf := &bytes.Buffer{}
+	client := &http.Client{
+		Transport: req2log.NewRoundTripper(
+			newTestLogger(buf),
+			nil,
+			req2log.RoundTripperRouteInfoResolver(func(req *http.Request) req2log.RouteInfo {
+				return req2log.RouteInfo{
+					Template:   "/users/{id}",
+					PathParams: map[string]string{"id": strings.TrimPrefix(req.URL.Path, "/users/")},
+				}
+			}),
+			req2log.RoundTripperHeaderParamPerms(req2log.NewParamPerms(nil, []string{"X-Secret"})),
+		),
+	}
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/users/1?q=query", io.NopCloser(strings.NewReader("abc")))
+	require.NoError(t, err)
+	req.Header.Set("X-Secret", "secret")
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	// entry is not logged until the response body is consumed
+	assert.Empty(t, buf.String())
+	_, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	matcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"time":         objmatcher.NewRegExpMatcher(".+"),
+		"type":         objmatcher.NewEqualsMatcher("request.2"),
+		"method":       objmatcher.NewEqualsMatcher("POST"),
+		"protocol":     objmatcher.NewEqualsMatcher("HTTP/1.1"),
+		"path":         objmatcher.NewEqualsMatcher("/users/{id}"),
+		"status":       objmatcher.NewEqualsMatcher(json.Number("202")),
+		"requestSize":  objmatcher.NewEqualsMatcher(json.Number("3")),
+		"responseSize": objmatcher.NewEqualsMatcher(json.Number("5")),
+		"duration":     objmatcher.NewAnyMatcher(),
+		"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+			"Host":                    objmatcher.NewEqualsMatcher(strings.TrimPrefix(server.URL, "http://")),
+			"response.Content-Length": objmatcher.NewEqualsMatcher("5"),
+			"response.Content-Type":   objmatcher.NewEqualsMatcher("text/plain; charset=utf-8"),
+			"response.Date":           objmatcher.NewRegExpMatcher(".+"),
+		}),
+		"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{"id": "1", "q": "query"}),
+	})
+	assert.NoError(t, matcher.Matches(map[string]interface{}(entries[0])))
+}
+
+func TestRoundTripperError(t *testing.T) {
+	buf := &bytes.Buffer{}
+	rt := req2log.NewRoundTripper(newTestLogger(buf), roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	}))
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:1/path", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.EqualError(t, err, "connection refused")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	matcher := objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+		"time":         objmatcher.NewRegExpMatcher(".+"),
+		"type":         objmatcher.NewEqualsMatcher("request.2"),
+		"method":       objmatcher.NewEqualsMatcher("GET"),
+		"protocol":     objmatcher.NewEqualsMatcher("HTTP/1.1"),
+		"path":         objmatcher.NewEqualsMatcher("/path"),
+		"status":       objmatcher.NewEqualsMatcher(json.Number("0")),
+		"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+		"responseSize": objmatcher.NewEqualsMatcher(json.Number("0")),
+		"duration":     objmatcher.NewAnyMatcher(),
+		"params":       objmatcher.NewEqualsMatcher(map[string]interface{}{"Host": "localhost:1"}),
+		"unsafeParams": objmatcher.NewEqualsMatcher(map[string]interface{}{"error": "connection refused"}),
+	})
+	assert.NoError(t, matcher.Matches(map[string]interface{}(entries[0])))
+}
+
+func TestRoundTripperErrorWithErrorParam(t *testing.T) {
+	buf := &bytes.Buffer{}
+	rt := req2log.NewRoundTripper(newTestLogger(buf), roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection refused")
+	}))
+	req, err := http.NewRequest(http.MethodGet, "http://localhost:1/path?error=queryVal", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.EqualError(t, err, "connection refused")
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, map[string]interface{}{
+		"error": []interface{}{"queryVal", "connection refused"},
+	}, entries[0]["unsafeParams"])
+}
+
+func TestRoundTripperTracing(t *testing.T) {
+	reqBuf, trcBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	rt := req2log.NewRoundTripper(
+		newTestLogger(reqBuf),
+		roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusNoContent, Proto: "HTTP/1.1", Body: http.NoBody}, nil
+		}),
+		req2log.RoundTripperTraceIDFromContext(),
+		req2log.RoundTripperTrc1Logger(trc1log.NewFromCreator(trcBuf, wlog.NewJSONMarshalLoggerProvider().NewLogger)),
+	)
+
+	tracer, err := wzipkin.NewTracer(wtracing.NewNoopReporter())
+	require.NoError(t, err)
+	parentSpan := tracer.StartSpan("parent")
+	ctx := wtracing.ContextWithSpan(context.Background(), parentSpan)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/path", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	traceID := string(parentSpan.Context().TraceID)
+	reqEntries, err := logreader.EntriesFromContent(reqBuf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(reqEntries))
+	assert.Equal(t, traceID, reqEntries[0]["traceId"])
+
+	trcEntries, err := logreader.EntriesFromContent(trcBuf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trcEntries))
+	span, ok := trcEntries[0]["span"].(map[string]interface{})
+	require.True(t, ok, "trace.1 entry does not have a span: %v", trcEntries[0])
+	assert.Equal(t, "GET /path", span["name"])
+	assert.Equal(t, traceID, span["traceId"])
+	assert.Equal(t, string(parentSpan.Context().ID), span["parentId"])
+}
+
+func TestRoundTripperUnclosedBody(t *testing.T) {
+	reqBuf, trcBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	rt := req2log.NewRoundTripper(
+		newTestLogger(reqBuf),
+		roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Proto: "HTTP/1.1", Body: io.NopCloser(strings.NewReader("hello"))}, nil
+		}),
+		req2log.RoundTripperTrc1Logger(trc1log.NewFromCreator(trcBuf, wlog.NewJSONMarshalLoggerProvider().NewLogger)),
+	)
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/path", nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	// nothing is logged until the body is read to completion or closed
+	assert.Empty(t, reqBuf.String())
+	assert.Empty(t, trcBuf.String())
+
+	require.NoError(t, resp.Body.Close())
+	reqEntries, err := logreader.EntriesFromContent(reqBuf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(reqEntries))
+	trcEntries, err := logreader.EntriesFromContent(trcBuf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(trcEntries))
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -97,3 +97,11 @@ func (l *warnLogger) log(logFn func(logger Logger)) {
 	logFn(NewFromCreator(buf, l.level, l.creator))
 	_, _ = fmt.Fprintln(l.w, wloginternal.WarnLoggerOutput("svc1log", buf.String(), 4))
 }
+
+func (l *warnLogger) Flush() error {
+	return wlog.FlushWriter(l.w)
+}
+
+func (l *warnLogger) Close() error {
+	return l.Flush()
+}
@@ -49,3 +49,11 @@ func (w *wrappedLogger) Enabled(level wlog.LogLevel) bool {
 	}
 	return true
 }
+
+func (w *wrappedLogger) Flush() error {
+	return wlog.Flush(w.logger)
+}
+
+func (w *wrappedLogger) Close() error {
+	return wlog.Close(w.logger)
+}
@@ -47,8 +47,13 @@ func (l *defaultLogger) Send(span wtracing.SpanModel) {
 	l.Log(span)
 }
 
+func (l *defaultLogger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+// Close closes the underlying logger using wlog.Close. It does not close the writer that the logger writes to.
 func (l *defaultLogger) Close() error {
-	return nil
+	return wlog.Close(l.logger)
 }
 
 func spanParam(span wtracing.SpanModel) wlog.Param {
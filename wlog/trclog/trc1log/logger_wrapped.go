@@ -15,6 +15,7 @@
 package trc1log
 
 import (
+	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-tracing/wtracing"
 )
 
@@ -34,3 +35,7 @@ func (w *wrappedLogger) Send(span wtracing.SpanModel) {
 func (w *wrappedLogger) Close() error {
 	return w.logger.Close()
 }
+
+func (w *wrappedLogger) Flush() error {
+	return wlog.Flush(w.logger)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+)
+
+// DefaultDiagnosticsInterval is the interval at which StartDiagnosticsCollection collects diagnostics if the provided
+// interval is not positive.
+const DefaultDiagnosticsInterval = time.Minute
+
+// StartDiagnosticsCollection logs the diagnostics of the provided collectors using the diag1log.Logger of the provided
+// context every interval. If interval is not positive, DefaultDiagnosticsInterval is used. Collectors that fail are
+// logged as warnings using the svc1log.Logger of the context. Collection runs until the returned function is called or
+// the provided context is done.
+func StartDiagnosticsCollection(ctx context.Context, interval time.Duration, collectors ...diag1log.Collector) (stop func()) {
+	if interval <= 0 {
+		interval = DefaultDiagnosticsInterval
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				logDiagnostics(ctx, collectors)
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+func logDiagnostics(ctx context.Context, collectors []diag1log.Collector, params ...diag1log.Param) {
+	if err := diag1log.LogDiagnostics(ctx, collectors, params...); err != nil {
+		svc1log.FromContext(ctx).Warn("Failed to collect diagnostics", svc1log.Stacktrace(err))
+	}
+}
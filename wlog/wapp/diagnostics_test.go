@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartDiagnosticsCollection(t *testing.T) {
+	w := make(chanWriter, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = diag1log.WithLogger(ctx, diag1log.New(w))
+	stop := wapp.StartDiagnosticsCollection(ctx, 10*time.Millisecond, testCollector("test.v1"))
+	defer stop()
+
+	// diagnostics are collected on every interval
+	for i := 0; i < 2; i++ {
+		select {
+		case out := <-w:
+			entries, err := logreader.EntriesFromContent(out)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(entries))
+			assert.Equal(t, "test.v1", genericDiagnosticType(t, entries[0]))
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for diagnostics")
+		}
+	}
+}
+
+func TestStartDiagnosticsCollectionDefaultInterval(t *testing.T) {
+	w := make(chanWriter, 1)
+	ctx := diag1log.WithLogger(context.Background(), diag1log.New(w))
+	// a non-positive interval uses the default interval rather than panicking
+	stop := wapp.StartDiagnosticsCollection(ctx, 0, testCollector("test.v1"))
+	stop()
+	select {
+	case out := <-w:
+		require.Fail(t, "unexpected diagnostics logged", string(out))
+	default:
+	}
+}
+
+func testCollector(diagnosticType string) diag1log.Collector {
+	return diag1log.CollectorFunc(func() (logging.Diagnostic, error) {
+		return logging.NewDiagnosticFromGeneric(logging.GenericDiagnostic{
+			DiagnosticType: diagnosticType,
+			Value:          map[string]interface{}{},
+		}), nil
+	})
+}
+
+// genericDiagnosticType returns the diagnostic type of the generic diagnostic of the provided diagnostic.1 entry.
+func genericDiagnosticType(t *testing.T, entry logreader.Entry) string {
+	diagnostic, ok := entry["diagnostic"].(map[string]interface{})
+	require.True(t, ok, "entry does not have a diagnostic: %v", entry)
+	generic, ok := diagnostic["generic"].(map[string]interface{})
+	require.True(t, ok, "diagnostic is not generic: %v", diagnostic)
+	diagnosticType, _ := generic["diagnosticType"].(string)
+	return diagnosticType
+}
+
+// chanWriter sends a copy of every write to the channel.
+type chanWriter chan []byte
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	w <- append([]byte(nil), p...)
+	return len(p), nil
+}
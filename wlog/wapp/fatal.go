@@ -28,17 +28,20 @@ import (
 
 // RunWithRecoveryLogging wraps a callback, logging any panics recovered as errors.
 // Useful as a "catch all" for applications so that they can log fatal events, perhaps before exiting.
+// The loggers in the context are flushed using FlushLoggers before returning.
 func RunWithRecoveryLogging(ctx context.Context, runFn func(ctx context.Context)) {
 	defer func() {
 		if r := recover(); r != nil {
 			_ = handleRecovered(ctx, r, debug.Stack())
 		}
+		_ = FlushLoggers(ctx)
 	}()
 	runFn(ctx)
 }
 
 // RunWithFatalLogging wraps a callback, logging errors and panics it returns.
 // Useful as a "catch all" for applications so that they can log fatal events, perhaps before exiting.
+// The loggers in the context are flushed using FlushLoggers before returning.
 func RunWithFatalLogging(ctx context.Context, runFn func(ctx context.Context) error) (retErr error) {
 	defer func() {
 		if retErr != nil {
@@ -49,6 +52,7 @@ func RunWithFatalLogging(ctx context.Context, runFn func(ctx context.Context) er
 				retErr = recovered
 			}
 		}
+		_ = FlushLoggers(ctx)
 	}()
 	return runFn(ctx)
 }
@@ -62,6 +66,7 @@ func RunWithRecoveryLoggingWithError(ctx context.Context, runFn func(ctx context
 				retErr = recovered
 			}
 		}
+		_ = FlushLoggers(ctx)
 	}()
 	return runFn(ctx)
 }
@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
+	"github.com/palantir/witchcraft-go-logging/wlog/beaconlog/beacon1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
+)
+
+// FlushLoggers flushes the loggers of every log type in the provided context using wlog.Flush so that the output they
+// buffer is not lost when the process exits. The errors of all loggers that fail to flush are returned joined together.
+func FlushLoggers(ctx context.Context) error {
+	return errors.Join(
+		wlog.Flush(svc1log.FromContext(ctx)),
+		wlog.Flush(evt1log.FromContext(ctx)),
+		wlog.Flush(evt2log.FromContext(ctx)),
+		wlog.Flush(metric1log.FromContext(ctx)),
+		wlog.Flush(trc1log.FromContext(ctx)),
+		wlog.Flush(audit2log.FromContext(ctx)),
+		wlog.Flush(audit3log.FromContext(ctx)),
+		wlog.Flush(diag1log.FromContext(ctx)),
+		wlog.Flush(req1log.FromContext(ctx)),
+		wlog.Flush(req2log.FromContext(ctx)),
+		wlog.Flush(beacon1log.FromContext(ctx)),
+	)
+}
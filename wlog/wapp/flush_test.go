@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushLoggers(t *testing.T) {
+	svcBuf, diagBuf := &bytes.Buffer{}, &bytes.Buffer{}
+	svcWriter, diagWriter := bufio.NewWriter(svcBuf), bufio.NewWriter(diagBuf)
+	ctx := svc1log.WithLogger(context.Background(), svc1log.New(svcWriter, wlog.InfoLevel))
+	ctx = diag1log.WithLogger(ctx, diag1log.New(diagWriter))
+
+	svc1log.FromContext(ctx).Info("hello")
+	wapp.DumpThreads(ctx)
+	assert.Empty(t, svcBuf.String())
+	assert.Empty(t, diagBuf.String())
+
+	require.NoError(t, wapp.FlushLoggers(ctx))
+	assert.Contains(t, svcBuf.String(), `"message":"hello"`)
+	assert.Contains(t, diagBuf.String(), `"type":"diagnostic.1"`)
+
+	// loggers that are not set in the context are no-ops and are not flushed
+	assert.NoError(t, wapp.FlushLoggers(context.Background()))
+}
+
+func TestRunWithFatalLogging_FlushesLoggers(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := svc1log.WithLogger(context.Background(), svc1log.New(bufio.NewWriter(buf), wlog.InfoLevel))
+	err := wapp.RunWithFatalLogging(ctx, func(ctx context.Context) error {
+		return fmt.Errorf("foo")
+	})
+	assert.EqualError(t, err, "foo")
+	assert.Contains(t, buf.String(), `"message":"error"`)
+}
+
+func TestRunWithRecoveryLogging_FlushesLoggers(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := svc1log.WithLogger(context.Background(), svc1log.New(bufio.NewWriter(buf), wlog.InfoLevel))
+	wapp.RunWithRecoveryLogging(ctx, func(ctx context.Context) {
+		panic("foo")
+	})
+	assert.Contains(t, buf.String(), `"message":"panic recovered"`)
+}
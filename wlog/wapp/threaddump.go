@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"runtime"
+	"sync"
+	"syscall"
+
+	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+)
+
+// exitCodeThreadDump is the exit code of the Go runtime when it crashes on SIGQUIT.
+const exitCodeThreadDump = 2
+
+// DumpThreads captures the stacks of all goroutines and logs them as a diagnostic.1 thread dump using the
+// diag1log.Logger of the provided context.
+func DumpThreads(ctx context.Context, params ...diag1log.Param) {
+	threadDump := diag1log.ThreadDumpV1FromGoroutines(allGoroutines())
+	diag1log.FromContext(ctx).Diagnostic(logging.NewDiagnosticFromThreadDump(threadDump), params...)
+}
+
+type ThreadDumpParam interface {
+	apply(h *threadDumpSignalHandler)
+}
+
+type threadDumpParamFunc func(h *threadDumpSignalHandler)
+
+func (f threadDumpParamFunc) apply(h *threadDumpSignalHandler) {
+	f(h)
+}
+
+// ThreadDumpSignals configures the signals that trigger a thread dump. The default signal is SIGQUIT.
+func ThreadDumpSignals(signals ...os.Signal) ThreadDumpParam {
+	return threadDumpParamFunc(func(h *threadDumpSignalHandler) {
+		h.signals = signals
+	})
+}
+
+// ThreadDumpExitAfterDump configures the process to exit with status 2 after a thread dump triggered by a signal has
+// been logged, which matches the behavior of the Go runtime on SIGQUIT. By default, the process keeps running.
+func ThreadDumpExitAfterDump() ThreadDumpParam {
+	return threadDumpParamFunc(func(h *threadDumpSignalHandler) {
+		h.exit = true
+	})
+}
+
+// ThreadDumpCollectors configures diagnostics that are collected and logged after each thread dump triggered by a
+// signal, such as those returned by diag1log.DefaultCollectors.
+func ThreadDumpCollectors(collectors ...diag1log.Collector) ThreadDumpParam {
+	return threadDumpParamFunc(func(h *threadDumpSignalHandler) {
+		h.collectors = append(h.collectors, collectors...)
+	})
+}
+
+// InstallThreadDumpSignalHandler installs a handler that calls DumpThreads with the provided context whenever the
+// process receives one of the configured signals. The signal is recorded as the "signal" unsafe parameter of the
+// diagnostic. Because the handler replaces the default behavior of the Go runtime for the signals, the process keeps
+// running after a dump unless ThreadDumpExitAfterDump is provided. The handler runs until the returned function is
+// called or the provided context is done, after which the default behavior for the signals is restored.
+func InstallThreadDumpSignalHandler(ctx context.Context, params ...ThreadDumpParam) (stop func()) {
+	h := &threadDumpSignalHandler{
+		signals: []os.Signal{syscall.SIGQUIT},
+	}
+	for _, p := range params {
+		if p == nil {
+			continue
+		}
+		p.apply(h)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, h.signals...)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				signal.Stop(signals)
+				return
+			case <-done:
+				return
+			case sig := <-signals:
+				signalParam := diag1log.UnsafeParam("signal", sig.String())
+				DumpThreads(ctx, signalParam)
+				logDiagnostics(ctx, h.collectors, signalParam)
+				if h.exit {
+					os.Exit(exitCodeThreadDump)
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(done)
+		})
+	}
+}
+
+type threadDumpSignalHandler struct {
+	signals    []os.Signal
+	exit       bool
+	collectors []diag1log.Collector
+}
+
+// allGoroutines returns the stacks of all goroutines in the format of runtime.Stack.
+func allGoroutines() []byte {
+	buf := make([]byte, 1<<16)
+	for {
+		if n := runtime.Stack(buf, true); n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
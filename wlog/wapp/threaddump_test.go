@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wapp_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpThreads(t *testing.T) {
+	buf := &bytes.Buffer{}
+	ctx := diag1log.WithLogger(context.Background(), diag1log.New(buf))
+	wapp.DumpThreads(ctx, diag1log.UnsafeParam("reason", "test"))
+
+	entries, err := logreader.EntriesFromContent(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "diagnostic.1", entries[0]["type"])
+	assert.Equal(t, map[string]interface{}{"reason": "test"}, entries[0]["unsafeParams"])
+	assert.Contains(t, threadDumpProcedures(t, entries[0]), "github.com/palantir/witchcraft-go-logging/wlog/wapp_test.TestDumpThreads")
+}
+
+// threadDumpProcedures returns the procedures of all stack frames in the thread dump of the provided diagnostic.1
+// entry.
+func threadDumpProcedures(t *testing.T, entry logreader.Entry) []string {
+	diagnostic, ok := entry["diagnostic"].(map[string]interface{})
+	require.True(t, ok, "entry does not have a diagnostic: %v", entry)
+	threadDump, ok := diagnostic["threadDump"].(map[string]interface{})
+	require.True(t, ok, "diagnostic is not a thread dump: %v", diagnostic)
+	threads, ok := threadDump["threads"].([]interface{})
+	require.True(t, ok, "thread dump does not have threads: %v", threadDump)
+	var procedures []string
+	for _, thread := range threads {
+		stackTrace, _ := thread.(map[string]interface{})["stackTrace"].([]interface{})
+		for _, frame := range stackTrace {
+			if procedure, ok := frame.(map[string]interface{})["procedure"].(string); ok {
+				procedures = append(procedures, procedure)
+			}
+		}
+	}
+	return procedures
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build unix
+
+package wapp_test
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/logreader"
+	"github.com/palantir/witchcraft-go-logging/wlog/wapp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallThreadDumpSignalHandler(t *testing.T) {
+	w := make(chanWriter, 1)
+	ctx := diag1log.WithLogger(context.Background(), diag1log.New(w))
+	stop := wapp.InstallThreadDumpSignalHandler(ctx, wapp.ThreadDumpSignals(syscall.SIGUSR1))
+	defer stop()
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+	var out []byte
+	select {
+	case out = <-w:
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "timed out waiting for thread dump")
+	}
+
+	entries, err := logreader.EntriesFromContent(out)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, "diagnostic.1", entries[0]["type"])
+	assert.Equal(t, map[string]interface{}{"signal": syscall.SIGUSR1.String()}, entries[0]["unsafeParams"])
+	assert.NotEmpty(t, threadDumpProcedures(t, entries[0]))
+}
+
+func TestInstallThreadDumpSignalHandlerCollectors(t *testing.T) {
+	w := make(chanWriter, 1)
+	ctx := diag1log.WithLogger(context.Background(), diag1log.New(w))
+	stop := wapp.InstallThreadDumpSignalHandler(ctx,
+		wapp.ThreadDumpSignals(syscall.SIGUSR2),
+		wapp.ThreadDumpCollectors(testCollector("test.v1")),
+	)
+	defer stop()
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGUSR2))
+	var entries []logreader.Entry
+	for len(entries) < 2 {
+		select {
+		case out := <-w:
+			outEntries, err := logreader.EntriesFromContent(out)
+			require.NoError(t, err)
+			entries = append(entries, outEntries...)
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for diagnostics")
+		}
+	}
+	assert.NotEmpty(t, threadDumpProcedures(t, entries[0]))
+	assert.Equal(t, "test.v1", genericDiagnosticType(t, entries[1]))
+	assert.Equal(t, map[string]interface{}{"signal": syscall.SIGUSR2.String()}, entries[1]["unsafeParams"])
+}
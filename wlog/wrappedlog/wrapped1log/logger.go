@@ -19,9 +19,11 @@ import (
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
@@ -29,10 +31,15 @@ import (
 
 type Logger interface {
 	Audit() audit2log.Logger
+	// AuditV3 returns a logger that writes audit.3 logs.
+	AuditV3() audit3log.Logger
 	Diagnostic() diag1log.Logger
 	Event() evt2log.Logger
 	Metric() metric1log.Logger
 	Request(params ...req2log.LoggerCreatorParam) req2log.Logger
+	// RequestV1 returns a logger that writes request.1 logs. Request should be used unless consumers of the logs
+	// require the request.1 format.
+	RequestV1(params ...req1log.LoggerCreatorParam) req1log.Logger
 	Service(params ...svc1log.Param) svc1log.Logger
 	Trace() trc1log.Logger
 }
@@ -37,3 +37,11 @@ func (l *wrappedAudit2Logger) toAuditParams(name string, result audit2log.AuditR
 	outParams[len(defaultTypeParam)+1] = wlog.NewParam(audit2PayloadParams(name, result, params).apply)
 	return outParams
 }
+
+func (l *wrappedAudit2Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedAudit2Logger) Close() error {
+	return wlog.Close(l.logger)
+}
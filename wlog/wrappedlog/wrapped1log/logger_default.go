@@ -15,14 +15,17 @@
 package wrapped1log
 
 import (
+	"errors"
 	"io"
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
@@ -50,6 +53,14 @@ func (l *defaultLogger) Audit() audit2log.Logger {
 	}
 }
 
+func (l *defaultLogger) AuditV3() audit3log.Logger {
+	return &wrappedAudit3Logger{
+		name:    l.name,
+		version: l.version,
+		logger:  l.logger,
+	}
+}
+
 func (l *defaultLogger) Diagnostic() diag1log.Logger {
 	return &wrappedDiag1Logger{
 		name:    l.name,
@@ -87,6 +98,19 @@ func (l *defaultLogger) Request(params ...req2log.LoggerCreatorParam) req2log.Lo
 	return loggerBuilder.build(l.writer)
 }
 
+func (l *defaultLogger) RequestV1(params ...req1log.LoggerCreatorParam) req1log.Logger {
+	loggerBuilder := &req1LoggerBuilder{
+		name:          l.name,
+		version:       l.version,
+		loggerCreator: l.creator,
+		idsExtractor:  extractor.NewDefaultIDsExtractor(),
+	}
+	for _, p := range params {
+		p.Apply(loggerBuilder)
+	}
+	return loggerBuilder.build(l.writer)
+}
+
 func (l *defaultLogger) Service(params ...svc1log.Param) svc1log.Logger {
 	return &wrappedSvc1Logger{
 		params:  params,
@@ -105,6 +129,14 @@ func (l *defaultLogger) Trace() trc1log.Logger {
 	}
 }
 
+func (l *defaultLogger) Flush() error {
+	return errors.Join(wlog.Flush(l.logger), wlog.Flush(l.levellogger))
+}
+
+func (l *defaultLogger) Close() error {
+	return errors.Join(wlog.Close(l.logger), wlog.Close(l.levellogger))
+}
+
 var defaultTypeParam = []wlog.Param{
 	wlog.NewParam(func(entry wlog.LogEntry) {
 		entry.StringValue(wlog.TypeKey, TypeValue)
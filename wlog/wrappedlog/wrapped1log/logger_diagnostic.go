@@ -37,3 +37,11 @@ func (l *wrappedDiag1Logger) toDiagParams(diagnostic logging.Diagnostic, inParam
 	outParams[len(defaultTypeParam)+1] = wlog.NewParam(diag1PayloadParams(diagnostic, inParams).apply)
 	return outParams
 }
+
+func (l *wrappedDiag1Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedDiag1Logger) Close() error {
+	return wlog.Close(l.logger)
+}
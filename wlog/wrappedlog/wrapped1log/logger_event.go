@@ -37,3 +37,11 @@ func (l *wrappedEvt2Logger) toEventParams(name string, params []evt2log.Param) [
 	outParams[len(defaultTypeParam)+1] = wlog.NewParam(evt2PayloadParams(name, params).apply)
 	return outParams
 }
+
+func (l *wrappedEvt2Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedEvt2Logger) Close() error {
+	return wlog.Close(l.logger)
+}
@@ -37,3 +37,11 @@ func (l *wrappedMetric1Logger) toMetricParams(metricName, metricType string, inP
 	outParams[len(defaultTypeParam)+1] = wlog.NewParam(metric1PayloadParams(metricName, metricType, inParams).apply)
 	return outParams
 }
+
+func (l *wrappedMetric1Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedMetric1Logger) Close() error {
+	return wlog.Close(l.logger)
+}
@@ -16,6 +16,7 @@ package wrapped1log
 
 import (
 	"io"
+	"net/netip"
 
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
@@ -23,12 +24,9 @@ import (
 )
 
 type wrappedReq2Logger struct {
-	name             string
-	version          string
-	idsExtractor     extractor.IDsFromRequest
-	pathParamPerms   req2log.ParamPerms
-	queryParamPerms  req2log.ParamPerms
-	headerParamPerms req2log.ParamPerms
+	name    string
+	version string
+	opts    req2log.ToParamsOptions
 
 	logger wlog.Logger
 }
@@ -38,25 +36,37 @@ func (l *wrappedReq2Logger) Request(r req2log.Request) {
 }
 
 func (l *wrappedReq2Logger) PathParamPerms() req2log.ParamPerms {
-	return l.pathParamPerms
+	return l.opts.PathParamPerms
 }
 
 func (l *wrappedReq2Logger) QueryParamPerms() req2log.ParamPerms {
-	return l.queryParamPerms
+	return l.opts.QueryParamPerms
 }
 
 func (l *wrappedReq2Logger) HeaderParamPerms() req2log.ParamPerms {
-	return l.headerParamPerms
+	return l.opts.HeaderParamPerms
+}
+
+func (l *wrappedReq2Logger) ResponseHeaderParamPerms() req2log.ParamPerms {
+	return l.opts.ResponseHeaderParamPerms
 }
 
 func (l *wrappedReq2Logger) toRequestParams(r req2log.Request) []wlog.Param {
 	outParams := make([]wlog.Param, len(defaultTypeParam)+2)
 	copy(outParams, defaultTypeParam)
 	outParams[len(defaultTypeParam)] = wlog.NewParam(wrappedTypeParams(l.name, l.version).apply)
-	outParams[len(defaultTypeParam)+1] = wlog.NewParam(req2PayloadParams(r, l.idsExtractor, l.pathParamPerms, l.queryParamPerms, l.headerParamPerms).apply)
+	outParams[len(defaultTypeParam)+1] = wlog.NewParam(req2PayloadParams(r, l.opts).apply)
 	return outParams
 }
 
+func (l *wrappedReq2Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedReq2Logger) Close() error {
+	return wlog.Close(l.logger)
+}
+
 type req2LoggerBuilder struct {
 	name    string
 	version string
@@ -72,6 +82,18 @@ type req2LoggerBuilder struct {
 
 	safeHeaderParams      []string
 	forbiddenHeaderParams []string
+
+	safeResponseHeaderParams      []string
+	forbiddenResponseHeaderParams []string
+
+	pathParamRules           []req2log.ParamRule
+	queryParamRules          []req2log.ParamRule
+	headerParamRules         []req2log.ParamRule
+	responseHeaderParamRules []req2log.ParamRule
+
+	trustedProxies []netip.Prefix
+	ipv4PrefixLen  int
+	ipv6PrefixLen  int
 }
 
 func (b *req2LoggerBuilder) LoggerCreator(creator wlog.LoggerCreator) {
@@ -106,15 +128,56 @@ func (b *req2LoggerBuilder) ForbiddenHeaderParams(forbiddenHeaderParams []string
 	b.forbiddenHeaderParams = append(b.forbiddenHeaderParams, forbiddenHeaderParams...)
 }
 
+func (b *req2LoggerBuilder) SafeResponseHeaderParams(safeResponseHeaderParams []string) {
+	b.safeResponseHeaderParams = append(b.safeResponseHeaderParams, safeResponseHeaderParams...)
+}
+
+func (b *req2LoggerBuilder) ForbiddenResponseHeaderParams(forbiddenResponseHeaderParams []string) {
+	b.forbiddenResponseHeaderParams = append(b.forbiddenResponseHeaderParams, forbiddenResponseHeaderParams...)
+}
+
+func (b *req2LoggerBuilder) PathParamRules(pathParamRules []req2log.ParamRule) {
+	b.pathParamRules = append(b.pathParamRules, pathParamRules...)
+}
+
+func (b *req2LoggerBuilder) QueryParamRules(queryParamRules []req2log.ParamRule) {
+	b.queryParamRules = append(b.queryParamRules, queryParamRules...)
+}
+
+func (b *req2LoggerBuilder) HeaderParamRules(headerParamRules []req2log.ParamRule) {
+	b.headerParamRules = append(b.headerParamRules, headerParamRules...)
+}
+
+func (b *req2LoggerBuilder) ResponseHeaderParamRules(responseHeaderParamRules []req2log.ParamRule) {
+	b.responseHeaderParamRules = append(b.responseHeaderParamRules, responseHeaderParamRules...)
+}
+
+func (b *req2LoggerBuilder) TrustedProxies(trustedProxies []netip.Prefix) {
+	b.trustedProxies = append(b.trustedProxies, trustedProxies...)
+}
+
+func (b *req2LoggerBuilder) ClientIPTruncation(ipv4PrefixLen, ipv6PrefixLen int) {
+	b.ipv4PrefixLen = ipv4PrefixLen
+	b.ipv6PrefixLen = ipv6PrefixLen
+}
+
 func (b *req2LoggerBuilder) build(w io.Writer) *wrappedReq2Logger {
 	defaultParams := req2log.DefaultRequestParamPerms()
 	return &wrappedReq2Logger{
-		name:             b.name,
-		version:          b.version,
-		idsExtractor:     b.idsExtractor,
-		pathParamPerms:   req2log.CombinedParamPerms(defaultParams.PathParamPerms(), req2log.NewParamPerms(b.safePathParams, b.forbiddenPathParams)),
-		queryParamPerms:  req2log.CombinedParamPerms(defaultParams.QueryParamPerms(), req2log.NewParamPerms(b.safeQueryParams, b.forbiddenQueryParams)),
-		headerParamPerms: req2log.CombinedParamPerms(defaultParams.HeaderParamPerms(), req2log.NewParamPerms(b.safeHeaderParams, b.forbiddenHeaderParams)),
+		name:    b.name,
+		version: b.version,
+		opts: req2log.ToParamsOptions{
+			IDsExtractor:             b.idsExtractor,
+			PathParamPerms:           req2log.CombinedParamPerms(defaultParams.PathParamPerms(), req2log.NewParamPerms(b.safePathParams, b.forbiddenPathParams), req2log.NewRuleParamPerms(b.pathParamRules...)),
+			QueryParamPerms:          req2log.CombinedParamPerms(defaultParams.QueryParamPerms(), req2log.NewParamPerms(b.safeQueryParams, b.forbiddenQueryParams), req2log.NewRuleParamPerms(b.queryParamRules...)),
+			HeaderParamPerms:         req2log.CombinedParamPerms(defaultParams.HeaderParamPerms(), req2log.NewParamPerms(b.safeHeaderParams, b.forbiddenHeaderParams), req2log.NewRuleParamPerms(b.headerParamRules...)),
+			ResponseHeaderParamPerms: req2log.CombinedParamPerms(defaultParams.ResponseHeaderParamPerms(), req2log.NewParamPerms(b.safeResponseHeaderParams, b.forbiddenResponseHeaderParams), req2log.NewRuleParamPerms(b.responseHeaderParamRules...)),
+			ClientIPResolver: &req2log.ClientIPResolver{
+				TrustedProxies: b.trustedProxies,
+				IPv4PrefixLen:  b.ipv4PrefixLen,
+				IPv6PrefixLen:  b.ipv6PrefixLen,
+			},
+		},
 
 		logger: b.loggerCreator(w),
 	}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wrapped1log
+
+import (
+	"io"
+
+	"github.com/palantir/witchcraft-go-logging/wlog"
+	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
+)
+
+type wrappedReq1Logger struct {
+	name             string
+	version          string
+	idsExtractor     extractor.IDsFromRequest
+	pathParamPerms   req1log.ParamPerms
+	queryParamPerms  req1log.ParamPerms
+	headerParamPerms req1log.ParamPerms
+
+	logger wlog.Logger
+}
+
+func (l *wrappedReq1Logger) Request(r req1log.Request) {
+	l.logger.Log(l.toRequestParams(r)...)
+}
+
+func (l *wrappedReq1Logger) PathParamPerms() req1log.ParamPerms {
+	return l.pathParamPerms
+}
+
+func (l *wrappedReq1Logger) QueryParamPerms() req1log.ParamPerms {
+	return l.queryParamPerms
+}
+
+func (l *wrappedReq1Logger) HeaderParamPerms() req1log.ParamPerms {
+	return l.headerParamPerms
+}
+
+func (l *wrappedReq1Logger) toRequestParams(r req1log.Request) []wlog.Param {
+	outParams := make([]wlog.Param, len(defaultTypeParam)+2)
+	copy(outParams, defaultTypeParam)
+	outParams[len(defaultTypeParam)] = wlog.NewParam(wrappedTypeParams(l.name, l.version).apply)
+	outParams[len(defaultTypeParam)+1] = wlog.NewParam(req1PayloadParams(r, l.idsExtractor, l.pathParamPerms, l.queryParamPerms, l.headerParamPerms).apply)
+	return outParams
+}
+
+func (l *wrappedReq1Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedReq1Logger) Close() error {
+	return wlog.Close(l.logger)
+}
+
+type req1LoggerBuilder struct {
+	name    string
+	version string
+
+	loggerCreator wlog.LoggerCreator
+	idsExtractor  extractor.IDsFromRequest
+
+	safePathParams      []string
+	forbiddenPathParams []string
+
+	safeQueryParams      []string
+	forbiddenQueryParams []string
+
+	safeHeaderParams      []string
+	forbiddenHeaderParams []string
+}
+
+func (b *req1LoggerBuilder) LoggerCreator(creator wlog.LoggerCreator) {
+	b.loggerCreator = creator
+}
+
+func (b *req1LoggerBuilder) IdsExtractor(idsExtractor extractor.IDsFromRequest) {
+	b.idsExtractor = idsExtractor
+}
+
+func (b *req1LoggerBuilder) SafePathParams(safePathParams []string) {
+	b.safePathParams = append(b.safePathParams, safePathParams...)
+}
+
+func (b *req1LoggerBuilder) ForbiddenPathParams(forbiddenPathParams []string) {
+	b.forbiddenPathParams = append(b.forbiddenPathParams, forbiddenPathParams...)
+}
+
+func (b *req1LoggerBuilder) SafeQueryParams(safeQueryParams []string) {
+	b.safeQueryParams = append(b.safeQueryParams, safeQueryParams...)
+}
+
+func (b *req1LoggerBuilder) ForbiddenQueryParams(forbiddenQueryParams []string) {
+	b.forbiddenQueryParams = append(b.forbiddenQueryParams, forbiddenQueryParams...)
+}
+
+func (b *req1LoggerBuilder) SafeHeaderParams(safeHeaderParams []string) {
+	b.safeHeaderParams = append(b.safeHeaderParams, safeHeaderParams...)
+}
+
+func (b *req1LoggerBuilder) ForbiddenHeaderParams(forbiddenHeaderParams []string) {
+	b.forbiddenHeaderParams = append(b.forbiddenHeaderParams, forbiddenHeaderParams...)
+}
+
+func (b *req1LoggerBuilder) build(w io.Writer) *wrappedReq1Logger {
+	defaultParams := req1log.DefaultRequestParamPerms()
+	return &wrappedReq1Logger{
+		name:             b.name,
+		version:          b.version,
+		idsExtractor:     b.idsExtractor,
+		pathParamPerms:   req1log.CombinedParamPerms(defaultParams.PathParamPerms(), req1log.NewParamPerms(b.safePathParams, b.forbiddenPathParams)),
+		queryParamPerms:  req1log.CombinedParamPerms(defaultParams.QueryParamPerms(), req1log.NewParamPerms(b.safeQueryParams, b.forbiddenQueryParams)),
+		headerParamPerms: req1log.CombinedParamPerms(defaultParams.HeaderParamPerms(), req1log.NewParamPerms(b.safeHeaderParams, b.forbiddenHeaderParams)),
+
+		logger: b.loggerCreator(w),
+	}
+}
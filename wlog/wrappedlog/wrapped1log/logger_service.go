@@ -67,3 +67,11 @@ func (l *wrappedSvc1Logger) toServiceParams(message string, levelParam wlog.Para
 	outParams[len(defaultTypeParam)+1] = wlog.NewParam(svc1PayloadParams(message, levelParam, append(l.params, inParams...)).apply)
 	return outParams
 }
+
+func (l *wrappedSvc1Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+func (l *wrappedSvc1Logger) Close() error {
+	return wlog.Close(l.logger)
+}
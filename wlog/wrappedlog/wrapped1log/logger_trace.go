@@ -43,6 +43,11 @@ func (l *wrappedTrc1Logger) Send(span wtracing.SpanModel) {
 	l.Log(span)
 }
 
+func (l *wrappedTrc1Logger) Flush() error {
+	return wlog.Flush(l.logger)
+}
+
+// Close closes the underlying logger using wlog.Close. It does not close the writer that the logger writes to.
 func (l *wrappedTrc1Logger) Close() error {
-	return nil
+	return wlog.Close(l.logger)
 }
@@ -18,10 +18,12 @@ import (
 	"github.com/palantir/witchcraft-go-logging/conjure/witchcraft/api/logging"
 	"github.com/palantir/witchcraft-go-logging/wlog"
 	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit2log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
 	"github.com/palantir/witchcraft-go-logging/wlog/diaglog/diag1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/evtlog/evt2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/extractor"
 	"github.com/palantir/witchcraft-go-logging/wlog/metriclog/metric1log"
+	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/reqlog/req2log"
 	"github.com/palantir/witchcraft-go-logging/wlog/svclog/svc1log"
 	"github.com/palantir/witchcraft-go-logging/wlog/trclog/trc1log"
@@ -37,11 +39,13 @@ const (
 	PayloadKey             = "payload"
 	PayloadTypeKey         = "type"
 	PayloadServiceLogV1    = "serviceLogV1"
+	PayloadRequestLogV1    = "requestLogV1"
 	PayloadRequestLogV2    = "requestLogV2"
 	PayloadTraceLogV1      = "traceLogV1"
 	PayloadEventLogV2      = "eventLogV2"
 	PayloadMetricLogV1     = "metricLogV1"
 	PayloadAuditLogV2      = "auditLogV2"
+	PayloadAuditLogV3      = "auditLogV3"
 	PayloadDiagnosticLogV1 = "diagnosticLogV1"
 )
 
@@ -74,6 +78,18 @@ func audit2PayloadParams(name string, result audit2log.AuditResultType, params [
 	})
 }
 
+func audit3PayloadParams(name string, result audit3log.AuditResultType, params []audit3log.Param) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		audit3Log := wlog.NewMapLogEntry()
+		wlog.ApplyParams(audit3Log, audit3log.ToParams(name, result, params))
+		payload := wlog.NewMapLogEntry()
+		payload.StringValue(PayloadTypeKey, PayloadAuditLogV3)
+		payload.AnyMapValue(PayloadAuditLogV3, audit3Log.AllValues())
+
+		entry.AnyMapValue(PayloadKey, payload.AllValues())
+	})
+}
+
 func diag1PayloadParams(diagnostic logging.Diagnostic, params []diag1log.Param) Param {
 	return paramFunc(func(entry wlog.LogEntry) {
 		diag1Log := wlog.NewMapLogEntry()
@@ -110,10 +126,22 @@ func metric1PayloadParams(metricName, metricType string, params []metric1log.Par
 	})
 }
 
-func req2PayloadParams(r req2log.Request, idsExtractor extractor.IDsFromRequest, pathParamPerms, queryParamPerms, headerParamPerms req2log.ParamPerms) Param {
+func req1PayloadParams(r req1log.Request, idsExtractor extractor.IDsFromRequest, pathParamPerms, queryParamPerms, headerParamPerms req1log.ParamPerms) Param {
+	return paramFunc(func(entry wlog.LogEntry) {
+		req1Log := wlog.NewMapLogEntry()
+		wlog.ApplyParams(req1Log, req1log.ToParams(r, idsExtractor, pathParamPerms, queryParamPerms, headerParamPerms))
+		payload := wlog.NewMapLogEntry()
+		payload.StringValue(PayloadTypeKey, PayloadRequestLogV1)
+		payload.AnyMapValue(PayloadRequestLogV1, req1Log.AllValues())
+
+		entry.AnyMapValue(PayloadKey, payload.AllValues())
+	})
+}
+
+func req2PayloadParams(r req2log.Request, opts req2log.ToParamsOptions) Param {
 	return paramFunc(func(entry wlog.LogEntry) {
 		req2Log := wlog.NewMapLogEntry()
-		wlog.ApplyParams(req2Log, req2log.ToParams(r, idsExtractor, pathParamPerms, queryParamPerms, headerParamPerms))
+		wlog.ApplyParams(req2Log, req2log.ToParamsWithOptions(r, opts))
 		payload := wlog.NewMapLogEntry()
 		payload.StringValue(PayloadTypeKey, PayloadRequestLogV2)
 		payload.AnyMapValue(PayloadRequestLogV2, req2Log.AllValues())
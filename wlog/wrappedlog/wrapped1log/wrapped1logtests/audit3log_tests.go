@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Palantir Technologies. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wrapped1logtests
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/palantir/pkg/objmatcher"
+	"github.com/palantir/pkg/safejson"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log"
+	"github.com/palantir/witchcraft-go-logging/wlog/auditlog/audit3log/audit3logtests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type Audit3TestCase struct {
+	audit3logtests.TestCase
+}
+
+// Audit3TestCases returns the audit3logtests test cases with their matchers updated to match the audit.3 entry wrapped
+// in a wrapped.1 entry with the provided entity name and version.
+func Audit3TestCases(entityName, entityVersion string) []Audit3TestCase {
+	var testCases []Audit3TestCase
+	for _, tc := range audit3logtests.TestCases() {
+		tc.JSONMatcher = map[string]objmatcher.Matcher{
+			"type":          objmatcher.NewEqualsMatcher("wrapped.1"),
+			"entityName":    objmatcher.NewEqualsMatcher(entityName),
+			"entityVersion": objmatcher.NewEqualsMatcher(entityVersion),
+			"payload": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+				"type":       objmatcher.NewEqualsMatcher("auditLogV3"),
+				"auditLogV3": tc.JSONMatcher,
+			}),
+		}
+		testCases = append(testCases, Audit3TestCase{TestCase: tc})
+	}
+	return testCases
+}
+
+func Audit3LogJSONTestSuite(t *testing.T, entityName, entityVersion string, loggerProvider func(w io.Writer) audit3log.Logger) {
+	audit3LogJSONOutputTests(t, entityName, entityVersion, loggerProvider)
+}
+
+func audit3LogJSONOutputTests(t *testing.T, entityName, entityVersion string, loggerProvider func(w io.Writer) audit3log.Logger) {
+	for i, tc := range Audit3TestCases(entityName, entityVersion) {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := loggerProvider(buf)
+
+			logger.Audit(tc.AuditName, tc.AuditResult, tc.Params...)
+
+			gotAuditLog := map[string]interface{}{}
+			logEntry := buf.Bytes()
+			err := safejson.Unmarshal(logEntry, &gotAuditLog)
+			require.NoError(t, err, "Case %d: %s\nAudit log line is not a valid map: %v", i, tc.Name, string(logEntry))
+
+			assert.NoError(t, tc.JSONMatcher.Matches(gotAuditLog), "Case %d: %s", i, tc.Name)
+		})
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/netip"
+	"regexp"
 	"testing"
 	"time"
 
@@ -31,13 +33,23 @@ import (
 )
 
 type Req2TestCase struct {
-	Name                  string
-	ExtraHeaderParams     map[string]string
-	ExtraQueryParams      []string
-	SafeHeaderParams      []string
-	SafeQueryParams       []string
-	ForbiddenHeaderParams []string
-	JSONMatcher           objmatcher.MapMatcher
+	Name                          string
+	ExtraHeaderParams             map[string]string
+	ExtraQueryParams              []string
+	SafeHeaderParams              []string
+	SafeQueryParams               []string
+	ForbiddenHeaderParams         []string
+	QueryParamRules               []req2log.ParamRule
+	HeaderParamRules              []req2log.ParamRule
+	ResponseHeader                http.Header
+	ResponseTrailer               http.Header
+	SafeResponseHeaderParams      []string
+	ForbiddenResponseHeaderParams []string
+	RemoteAddr                    string
+	TrustedProxies                []netip.Prefix
+	IPv4PrefixLen                 int
+	IPv6PrefixLen                 int
+	JSONMatcher                   objmatcher.MapMatcher
 }
 
 func Req2TestCases(entityName, entityVersion string) []Req2TestCase {
@@ -247,6 +259,128 @@ func Req2TestCases(entityName, entityVersion string) []Req2TestCase {
 				}),
 			},
 		},
+		{
+			Name:             "request.2 log entry with hashed and redacted rules",
+			HeaderParamRules: []req2log.ParamRule{req2log.HashedParamRule(req2log.GlobParamNames("foo*"))},
+			QueryParamRules: []req2log.ParamRule{
+				req2log.RedactedParamRule(req2log.RegexpParamNames(regexp.MustCompile("^foo"))),
+				req2log.ForbiddenParamRule(req2log.GlobParamNames("*bar*")),
+			},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":          objmatcher.NewEqualsMatcher("wrapped.1"),
+				"entityName":    objmatcher.NewEqualsMatcher(entityName),
+				"entityVersion": objmatcher.NewEqualsMatcher(entityVersion),
+				"payload": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"type": objmatcher.NewEqualsMatcher("requestLogV2"),
+					"requestLogV2": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+						"type":     objmatcher.NewEqualsMatcher("request.2"),
+						"time":     objmatcher.NewRegExpMatcher(".+"),
+						"method":   objmatcher.NewEqualsMatcher("GET"),
+						"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+						"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+						"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+							"Fooheaderparamname": objmatcher.NewEqualsMatcher("sha256:4de4581cb7ed1ba3"),
+							"fooQueryVarName":    objmatcher.NewEqualsMatcher("REDACTED"),
+						}),
+						"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+						"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+						"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+						"duration":     objmatcher.NewAnyMatcher(),
+						"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+						"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+						"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+						"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+					}),
+				}),
+			},
+		},
+		{
+			Name: "request.2 log entry with response headers and trailers",
+			ResponseHeader: http.Header{
+				"Content-Type": []string{"application/json"},
+				"Set-Cookie":   []string{"session=secret"},
+				"X-Error-Code": []string{"Default:Timeout"},
+			},
+			ResponseTrailer: http.Header{
+				"X-Checksum": []string{"checksumVal"},
+			},
+			SafeResponseHeaderParams:      []string{"X-Error-Code"},
+			ForbiddenResponseHeaderParams: []string{"X-Checksum"},
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":          objmatcher.NewEqualsMatcher("wrapped.1"),
+				"entityName":    objmatcher.NewEqualsMatcher(entityName),
+				"entityVersion": objmatcher.NewEqualsMatcher(entityVersion),
+				"payload": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"type": objmatcher.NewEqualsMatcher("requestLogV2"),
+					"requestLogV2": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+						"type":     objmatcher.NewEqualsMatcher("request.2"),
+						"time":     objmatcher.NewRegExpMatcher(".+"),
+						"method":   objmatcher.NewEqualsMatcher("GET"),
+						"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+						"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+						"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+							"response.Content-Type": objmatcher.NewEqualsMatcher("application/json"),
+							"response.X-Error-Code": objmatcher.NewEqualsMatcher("Default:Timeout"),
+						}),
+						"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+						"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+						"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+						"duration":     objmatcher.NewAnyMatcher(),
+						"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+						"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+						"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+						"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+						"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+							"fooQueryVarName":    objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+							"barQueryVarName":    objmatcher.NewEqualsMatcher("barQueryVarVal"),
+							"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+						}),
+					}),
+				}),
+			},
+		},
+		{
+			Name:              "request.2 log entry with client IP resolved through trusted proxy",
+			ExtraHeaderParams: map[string]string{"X-Forwarded-For": "198.51.100.1, 203.0.113.7"},
+			SafeHeaderParams:  []string{req2log.ClientIPParamKey},
+			RemoteAddr:        "10.0.0.1:52000",
+			TrustedProxies:    []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			IPv4PrefixLen:     24,
+			IPv6PrefixLen:     48,
+			JSONMatcher: map[string]objmatcher.Matcher{
+				"type":          objmatcher.NewEqualsMatcher("wrapped.1"),
+				"entityName":    objmatcher.NewEqualsMatcher(entityName),
+				"entityVersion": objmatcher.NewEqualsMatcher(entityVersion),
+				"payload": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+					"type": objmatcher.NewEqualsMatcher("requestLogV2"),
+					"requestLogV2": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+						"type":     objmatcher.NewEqualsMatcher("request.2"),
+						"time":     objmatcher.NewRegExpMatcher(".+"),
+						"method":   objmatcher.NewEqualsMatcher("GET"),
+						"protocol": objmatcher.NewEqualsMatcher("HTTP/1.1"),
+						"path":     objmatcher.NewEqualsMatcher("/some/path/here"),
+						"params": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+							"clientIp": objmatcher.NewEqualsMatcher("203.0.113.0/24"),
+						}),
+						"status":       objmatcher.NewEqualsMatcher(json.Number("200")),
+						"requestSize":  objmatcher.NewEqualsMatcher(json.Number("0")),
+						"responseSize": objmatcher.NewEqualsMatcher(json.Number("100")),
+						"duration":     objmatcher.NewAnyMatcher(),
+						"uid":          objmatcher.NewEqualsMatcher("be9f645d-52e0-49e9-ba31-db32927615db"),
+						"sid":          objmatcher.NewEqualsMatcher("ad4d4ae6-65af-4e2a-91a3-cf401acb1d4c"),
+						"tokenId":      objmatcher.NewEqualsMatcher("9277f9af-8d99-408a-94ef-f51e82be2ff8"),
+						"orgId":        objmatcher.NewEqualsMatcher("0998e573-31d7-4999-8bf9-0bc5f4592db9"),
+						"unsafeParams": objmatcher.MapMatcher(map[string]objmatcher.Matcher{
+							"fooQueryVarName":    objmatcher.NewEqualsMatcher("fooQueryVarVal"),
+							"barQueryVarName":    objmatcher.NewEqualsMatcher("barQueryVarVal"),
+							"Fooheaderparamname": objmatcher.NewEqualsMatcher("fooHeaderParamVal"),
+							"X-Forwarded-For":    objmatcher.NewEqualsMatcher("198.51.100.1, 203.0.113.7"),
+							"proxyChain":         objmatcher.NewEqualsMatcher("10.0.0.1"),
+						}),
+					}),
+				}),
+			},
+		},
 	}
 }
 
@@ -269,13 +403,22 @@ func req2LogJSONOutputTests(t *testing.T, entityName, entityVersion string, logg
 				req2log.SafeQueryParams(tc.SafeQueryParams...),
 				req2log.SafeHeaderParams(tc.SafeHeaderParams...),
 				req2log.ForbiddenHeaderParams(tc.ForbiddenHeaderParams...),
+				req2log.QueryParamRules(tc.QueryParamRules...),
+				req2log.HeaderParamRules(tc.HeaderParamRules...),
+				req2log.SafeResponseHeaderParams(tc.SafeResponseHeaderParams...),
+				req2log.ForbiddenResponseHeaderParams(tc.ForbiddenResponseHeaderParams...),
+				req2log.TrustedProxies(tc.TrustedProxies...),
+				req2log.ClientIPTruncation(tc.IPv4PrefixLen, tc.IPv6PrefixLen),
 			)
+			req.RemoteAddr = tc.RemoteAddr
 			logger.Request(req2log.Request{
-				Request:        req,
-				RouteInfo:      req2log.RouteInfo{},
-				ResponseStatus: http.StatusOK,
-				ResponseSize:   int64(100),
-				Duration:       1 * time.Second,
+				Request:         req,
+				RouteInfo:       req2log.RouteInfo{},
+				ResponseStatus:  http.StatusOK,
+				ResponseSize:    int64(100),
+				Duration:        1 * time.Second,
+				ResponseHeader:  tc.ResponseHeader,
+				ResponseTrailer: tc.ResponseTrailer,
 			})
 
 			entries, err := logreader.EntriesFromContent(buf.Bytes())